@@ -0,0 +1,83 @@
+// Command xelon-export writes the objects of a Xelon tenant as Terraform
+// configuration with import blocks, to onboard existing tenants in one pass.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/Xelon-AG/terraform-provider-xelon/internal/provider"
+)
+
+var (
+	version = "dev"
+)
+
+func main() {
+	var options provider.ExportOptions
+	var outputDir string
+	flag.StringVar(&options.TenantID, "tenant-id", "", "ID of the tenant to export, defaults to XELON_TENANT_ID or the tenant of the token")
+	flag.StringVar(&options.ObjectStorageRegion, "object-storage-region", "", "region of the object storage users, which are skipped if empty")
+	flag.StringVar(&outputDir, "out", ".", "directory to write the configuration files to")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), `Usage: xelon-export [flags]
+
+Writes the objects of a Xelon tenant as Terraform configuration with import
+blocks, which requires Terraform v1.12.0 or later. The credentials and other
+settings are read from the XELON_* environment variables and the XELON_PROFILE
+profile, like for an empty provider block.
+
+Flags:
+`)
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	log.SetFlags(0)
+
+	ctx := context.Background()
+	resources, diags := provider.Export(ctx, version, options)
+	for _, diagnostic := range diags {
+		log.Printf("%s: %s: %s", diagnostic.Severity(), diagnostic.Summary(), diagnostic.Detail())
+	}
+	if diags.HasError() {
+		os.Exit(1)
+	}
+
+	files, err := provider.GenerateConfig(ctx, resources)
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+	if err := writeFiles(outputDir, files); err != nil {
+		log.Fatal(err.Error())
+	}
+
+	log.Printf("Exported %d objects to %s", len(resources), outputDir)
+}
+
+// writeFiles writes the files to the directory, without overwriting any
+// existing file.
+func writeFiles(dir string, files map[string][]byte) error {
+	names := make([]string, 0, len(files))
+	for name := range files {
+		if _, err := os.Stat(filepath.Join(dir, name)); err == nil {
+			return fmt.Errorf("%s already exists, export to an empty directory", filepath.Join(dir, name))
+		}
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	for _, name := range names {
+		if err := os.WriteFile(filepath.Join(dir, name), files[name], 0o644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
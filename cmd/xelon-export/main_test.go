@@ -0,0 +1,24 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteFiles(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string][]byte{"xelon_network.tf": []byte("# network\n")}
+
+	require.NoError(t, writeFiles(dir, files))
+	content, err := os.ReadFile(filepath.Join(dir, "xelon_network.tf"))
+	require.NoError(t, err)
+	assert.Equal(t, "# network\n", string(content))
+
+	err = writeFiles(dir, files)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "already exists")
+}
@@ -0,0 +1,3 @@
+data "xelon_device" "server" {
+  id = "2d4b6f8a1c3e"
+}
@@ -0,0 +1,3 @@
+data "xelon_device" "server" {
+  display_name = "server"
+}
@@ -0,0 +1 @@
+data "xelon_devices" "all" {}
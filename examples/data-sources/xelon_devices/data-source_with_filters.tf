@@ -0,0 +1,4 @@
+data "xelon_devices" "web" {
+  display_name = "web"
+  tenant_id    = "<tenant-id>"
+}
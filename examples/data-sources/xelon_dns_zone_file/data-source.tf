@@ -0,0 +1,7 @@
+data "xelon_dns_zone_file" "example" {
+  zone_id = "<dns-zone-id>"
+}
+
+output "zone_file" {
+  value = data.xelon_dns_zone_file.example.content
+}
@@ -0,0 +1,19 @@
+ephemeral "xelon_kubernetes_cluster_kubeconfig" "example" {
+  kubernetes_cluster_id = "<kubernetes-cluster-id>"
+}
+
+provider "kubernetes" {
+  host                   = ephemeral.xelon_kubernetes_cluster_kubeconfig.example.host
+  cluster_ca_certificate = ephemeral.xelon_kubernetes_cluster_kubeconfig.example.cluster_ca_certificate
+  client_certificate     = ephemeral.xelon_kubernetes_cluster_kubeconfig.example.client_certificate
+  client_key             = ephemeral.xelon_kubernetes_cluster_kubeconfig.example.client_key
+}
+
+provider "helm" {
+  kubernetes = {
+    host                   = ephemeral.xelon_kubernetes_cluster_kubeconfig.example.host
+    cluster_ca_certificate = ephemeral.xelon_kubernetes_cluster_kubeconfig.example.cluster_ca_certificate
+    client_certificate     = ephemeral.xelon_kubernetes_cluster_kubeconfig.example.client_certificate
+    client_key             = ephemeral.xelon_kubernetes_cluster_kubeconfig.example.client_key
+  }
+}
@@ -0,0 +1,3 @@
+ephemeral "xelon_kubernetes_cluster_talosconfig" "example" {
+  kubernetes_cluster_id = "<kubernetes-cluster-id>"
+}
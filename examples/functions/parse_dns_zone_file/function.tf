@@ -0,0 +1,28 @@
+terraform {
+  required_providers {
+    xelon = {
+      source = "Xelon-AG/xelon"
+    }
+  }
+}
+
+resource "xelon_dns_zone" "example" {
+  name = "mydomain.com"
+}
+
+resource "xelon_dns_record" "imported" {
+  for_each = {
+    for record in provider::xelon::parse_dns_zone_file(file("mydomain.com.zone"), "mydomain.com") : record.key => record
+  }
+
+  zone_id  = xelon_dns_zone.example.id
+  name     = each.value.name
+  type     = each.value.type
+  content  = each.value.content
+  ttl      = each.value.ttl
+  flags    = each.value.flags
+  port     = each.value.port
+  priority = each.value.priority
+  tag      = each.value.tag
+  weight   = each.value.weight
+}
@@ -0,0 +1,11 @@
+list "xelon_device" "example" {
+  provider = xelon
+
+  # include the device attributes to generate the resource configuration
+  include_resource = true
+
+  config {
+    display_name = "web"
+    tenant_id    = "<tenant-id>"
+  }
+}
@@ -0,0 +1,7 @@
+list "xelon_dns_record" "example" {
+  provider = xelon
+
+  config {
+    zone_id = "<dns-zone-id>"
+  }
+}
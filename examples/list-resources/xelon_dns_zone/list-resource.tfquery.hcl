@@ -0,0 +1,3 @@
+list "xelon_dns_zone" "example" {
+  provider = xelon
+}
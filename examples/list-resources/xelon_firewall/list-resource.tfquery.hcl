@@ -0,0 +1,7 @@
+list "xelon_firewall" "example" {
+  provider = xelon
+
+  config {
+    tenant_id = "<tenant-id>"
+  }
+}
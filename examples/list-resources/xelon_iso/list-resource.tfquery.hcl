@@ -0,0 +1,7 @@
+list "xelon_iso" "example" {
+  provider = xelon
+
+  config {
+    name = "ubuntu"
+  }
+}
@@ -0,0 +1,7 @@
+list "xelon_load_balancer" "example" {
+  provider = xelon
+
+  config {
+    tenant_id = "<tenant-id>"
+  }
+}
@@ -0,0 +1,7 @@
+list "xelon_network" "example" {
+  provider = xelon
+
+  config {
+    tenant_id = "<tenant-id>"
+  }
+}
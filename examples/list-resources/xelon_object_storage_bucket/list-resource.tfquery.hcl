@@ -0,0 +1,7 @@
+list "xelon_object_storage_bucket" "example" {
+  provider = xelon
+
+  config {
+    user_id = "<object-storage-user-id>"
+  }
+}
@@ -0,0 +1,7 @@
+list "xelon_object_storage_user" "example" {
+  provider = xelon
+
+  config {
+    region = "zh1"
+  }
+}
@@ -0,0 +1,7 @@
+list "xelon_persistent_storage" "example" {
+  provider = xelon
+
+  config {
+    tenant_id = "<tenant-id>"
+  }
+}
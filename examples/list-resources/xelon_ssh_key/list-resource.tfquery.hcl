@@ -0,0 +1,7 @@
+list "xelon_ssh_key" "example" {
+  provider = xelon
+
+  config {
+    name = "deploy"
+  }
+}
@@ -0,0 +1,7 @@
+list "xelon_template" "example" {
+  provider = xelon
+
+  config {
+    name = "ubuntu"
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = xelon_device_backup.example
+  identity = {
+    device_id = "1a2b3c4d5e6f"
+  }
+}
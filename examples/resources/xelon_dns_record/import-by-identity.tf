@@ -0,0 +1,7 @@
+import {
+  to = xelon_dns_record.www
+  identity = {
+    record_id = 835224497
+    zone_id   = "fd1a48c97052"
+  }
+}
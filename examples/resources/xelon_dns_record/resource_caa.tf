@@ -0,0 +1,14 @@
+resource "xelon_dns_zone" "public" {
+  name = "mydomain.com"
+}
+
+resource "xelon_dns_record" "letsencrypt" {
+  zone_id = xelon_dns_zone.public.id
+
+  name    = "@"
+  type    = "CAA"
+  flags   = 0
+  tag     = "issue"
+  content = "letsencrypt.org"
+  ttl     = 3600
+}
@@ -0,0 +1,13 @@
+resource "xelon_dns_zone" "public" {
+  name = "mydomain.com"
+}
+
+resource "xelon_dns_record" "mail" {
+  zone_id = xelon_dns_zone.public.id
+
+  name     = "@"
+  type     = "MX"
+  priority = 10
+  content  = "mail.mydomain.com"
+  ttl      = 3600
+}
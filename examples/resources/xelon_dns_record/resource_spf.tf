@@ -0,0 +1,12 @@
+resource "xelon_dns_zone" "public" {
+  name = "mydomain.com"
+}
+
+resource "xelon_dns_record" "spf" {
+  zone_id = xelon_dns_zone.public.id
+
+  name    = "@"
+  type    = "TXT"
+  content = "v=spf1 include:_spf.mydomain.com ~all"
+  ttl     = 3600
+}
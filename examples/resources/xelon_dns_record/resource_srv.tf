@@ -0,0 +1,15 @@
+resource "xelon_dns_zone" "public" {
+  name = "mydomain.com"
+}
+
+resource "xelon_dns_record" "sip" {
+  zone_id = xelon_dns_zone.public.id
+
+  name     = "_sip._tcp"
+  type     = "SRV"
+  priority = 10
+  weight   = 5
+  port     = 5060
+  content  = "sip.mydomain.com"
+  ttl      = 3600
+}
@@ -0,0 +1,6 @@
+import {
+  to = xelon_dns_records.example
+  identity = {
+    zone_id = "123456abcdef"
+  }
+}
@@ -0,0 +1 @@
+terraform import xelon_dns_records.example 123456abcdef
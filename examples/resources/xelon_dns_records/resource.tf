@@ -0,0 +1,36 @@
+resource "xelon_dns_zone" "public" {
+  name = "mydomain.com"
+}
+
+resource "xelon_dns_records" "public" {
+  zone_id       = xelon_dns_zone.public.id
+  authoritative = true
+
+  records = [
+    {
+      name    = "@"
+      type    = "A"
+      content = "203.0.113.10"
+      ttl     = 3600
+    },
+    {
+      name    = "www"
+      type    = "CNAME"
+      content = "mydomain.com"
+      ttl     = 3600
+    },
+    {
+      name     = "@"
+      type     = "MX"
+      priority = 10
+      content  = "mail.mydomain.com"
+      ttl      = 3600
+    },
+    {
+      name    = "@"
+      type    = "TXT"
+      content = "v=spf1 mx -all"
+      ttl     = 3600
+    },
+  ]
+}
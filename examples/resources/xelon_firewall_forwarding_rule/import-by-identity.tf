@@ -0,0 +1,7 @@
+import {
+  to = xelon_firewall_forwarding_rule.web
+  identity = {
+    firewall_id = "1a2b3c4d5e6f"
+    id          = "7g8h9i0j1k2l"
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = xelon_kubernetes_cluster.staging
+  identity = {
+    id = "1a2b3c4d5e6f"
+  }
+}
@@ -0,0 +1 @@
+terraform import xelon_kubernetes_cluster.staging 1a2b3c4d5e6f
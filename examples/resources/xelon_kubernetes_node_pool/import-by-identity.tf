@@ -0,0 +1,7 @@
+import {
+  to = xelon_kubernetes_node_pool.default
+  identity = {
+    id                    = "7g8h9i0j1k2l"
+    kubernetes_cluster_id = "1a2b3c4d5e6f"
+  }
+}
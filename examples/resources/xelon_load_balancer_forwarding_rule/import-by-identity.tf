@@ -0,0 +1,7 @@
+import {
+  to = xelon_load_balancer_forwarding_rule.web
+  identity = {
+    id               = "7g8h9i0j1k2l"
+    load_balancer_id = "1a2b3c4d5e6f"
+  }
+}
@@ -0,0 +1 @@
+terraform import xelon_load_balancer_forwarding_rule.web 1a2b3c4d5e6f/7g8h9i0j1k2l
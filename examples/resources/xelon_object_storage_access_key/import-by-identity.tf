@@ -0,0 +1,7 @@
+import {
+  to = xelon_object_storage_access_key.test
+  identity = {
+    id      = "0d0d1416c88b"
+    user_id = "153096db-01c4-4c89-93c1-8cee2229a8f1"
+  }
+}
@@ -0,0 +1,7 @@
+import {
+  to = xelon_object_storage_bucket.example
+  identity = {
+    name    = "example-bucket"
+    user_id = "153096db-01c4-4c89-93c1-8cee2229a8f1"
+  }
+}
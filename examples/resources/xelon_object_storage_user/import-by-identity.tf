@@ -0,0 +1,7 @@
+import {
+  to = xelon_object_storage_user.test
+  identity = {
+    id     = "00000000-0000-0000-0000-000000000000"
+    region = "zh1"
+  }
+}
@@ -0,0 +1,7 @@
+import {
+  to = xelon_tenant_user.example
+  identity = {
+    id        = "abcdef123456"
+    tenant_id = "123456abcdef"
+  }
+}
@@ -3,7 +3,10 @@ resource "xelon_tenant_user" "example" {
   email      = "john.doe@example.com"
   first_name = "John"
   last_name  = "Doe"
-  password   = var.tenant_user_password
+
+  # the password is not stored in the state, increment the version to update it
+  password_wo         = var.tenant_user_password
+  password_wo_version = 1
 
   roles       = ["hq_root_admin"]
   permissions = ["allow_view_virtual_machines"]
@@ -14,4 +17,5 @@ data "xelon_tenant" "example" {}
 variable "tenant_user_password" {
   type      = string
   sensitive = true
+  ephemeral = true
 }
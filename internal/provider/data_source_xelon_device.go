@@ -0,0 +1,300 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/Xelon-AG/xelon-sdk-go/xelon"
+)
+
+var (
+	_ datasource.DataSource              = (*deviceDataSource)(nil)
+	_ datasource.DataSourceWithConfigure = (*deviceDataSource)(nil)
+)
+
+// deviceDataSource is the device data source implementation.
+type deviceDataSource struct {
+	client *xelon.Client
+}
+
+// deviceDataSourceModel maps the device datasource schema data.
+type deviceDataSourceModel struct {
+	CPUCoreCount types.Int64                    `tfsdk:"cpu_core_count"`
+	Disks        []deviceDiskDataSourceModel    `tfsdk:"disks"`
+	DisplayName  types.String                   `tfsdk:"display_name"`
+	Hostname     types.String                   `tfsdk:"hostname"`
+	ID           types.String                   `tfsdk:"id"`
+	Memory       types.Int64                    `tfsdk:"memory"`
+	Networks     []deviceNetworkDataSourceModel `tfsdk:"networks"`
+	PowerState   types.String                   `tfsdk:"power_state"`
+	TenantID     types.String                   `tfsdk:"tenant_id"`
+}
+
+type deviceDiskDataSourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	Size       types.Int64  `tfsdk:"size"`
+	UnitNumber types.Int64  `tfsdk:"unit_number"`
+}
+
+type deviceNetworkDataSourceModel struct {
+	Connected types.Bool   `tfsdk:"connected"`
+	ID        types.String `tfsdk:"id"`
+	IPAddress types.String `tfsdk:"ipv4_address"`
+}
+
+func NewDeviceDataSource() datasource.DataSource {
+	return &deviceDataSource{}
+}
+
+func (d *deviceDataSource) Metadata(_ context.Context, _ datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "xelon_device"
+}
+
+func (d *deviceDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		MarkdownDescription: `
+The device data source provides information about an existing device.
+`,
+		Attributes: map[string]schema.Attribute{
+			"cpu_core_count": schema.Int64Attribute{
+				MarkdownDescription: "The number of CPU cores allocated to the device.",
+				Computed:            true,
+			},
+			"disks": schema.ListNestedAttribute{
+				MarkdownDescription: "The disks attached to the device, ordered by unit number.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the disk.",
+							Computed:            true,
+						},
+						"size": schema.Int64Attribute{
+							MarkdownDescription: "The size of the disk in GB.",
+							Computed:            true,
+						},
+						"unit_number": schema.Int64Attribute{
+							MarkdownDescription: "The unit number of the disk.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"display_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the device.",
+				Computed:            true,
+				Optional:            true,
+			},
+			"hostname": schema.StringAttribute{
+				MarkdownDescription: "The hostname of the device.",
+				Computed:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the device.",
+				Computed:            true,
+				Optional:            true,
+			},
+			"memory": schema.Int64Attribute{
+				MarkdownDescription: "The amount of RAM in GB allocated to the device.",
+				Computed:            true,
+			},
+			"networks": schema.ListNestedAttribute{
+				MarkdownDescription: "The networks the device is attached to.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"connected": schema.BoolAttribute{
+							MarkdownDescription: "Whether the network is connected.",
+							Computed:            true,
+						},
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the network.",
+							Computed:            true,
+						},
+						"ipv4_address": schema.StringAttribute{
+							MarkdownDescription: "The IPv4 address assigned to the device on this network.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"power_state": schema.StringAttribute{
+				MarkdownDescription: "The power state of the device (`on` or `off`).",
+				Computed:            true,
+			},
+			"tenant_id": schema.StringAttribute{
+				MarkdownDescription: "The tenant ID to whom the device belongs.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *deviceDataSource) Configure(_ context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	client, ok := request.ProviderData.(*xelon.Client)
+	if !ok {
+		response.Diagnostics.AddError(
+			"Unconfigured Xelon client",
+			"Please report this issue to the provider developers.",
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *deviceDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data deviceDataSourceModel
+
+	diags := request.Config.Get(ctx, &data)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	deviceID := data.ID.ValueString()
+	deviceName := data.DisplayName.ValueString()
+	if deviceID == "" && deviceName == "" {
+		response.Diagnostics.AddError(
+			"Missing required attributes",
+			`The attribute "id" or "display_name" must be defined.`,
+		)
+		return
+	}
+
+	var device *xelon.Device
+	if deviceID != "" {
+		tflog.Info(ctx, "Searching for device by ID", map[string]any{"device_id": deviceID})
+
+		tflog.Debug(ctx, "Getting device", map[string]any{"device_id": deviceID})
+		var resp *xelon.Response
+		var err error
+		device, resp, err = d.client.Devices.Get(ctx, deviceID)
+		if err != nil {
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				response.Diagnostics.AddError("No search results", "Please refine your search.")
+				return
+			}
+			response.Diagnostics.AddError("Unable to get device", err.Error())
+			return
+		}
+		tflog.Debug(ctx, "Got device", map[string]any{"data": device, "device_id": deviceID})
+
+		// if name is defined check that it's equal
+		if deviceName != "" && deviceName != device.DisplayName {
+			response.Diagnostics.AddError(
+				"Ambiguous search result",
+				fmt.Sprintf("Specified and actual device name are different: expected '%s', got '%s'.", deviceName, device.DisplayName),
+			)
+			return
+		}
+	} else {
+		tflog.Info(ctx, "Searching for device by name", map[string]any{"device_name": deviceName})
+
+		tflog.Debug(ctx, "Getting devices", map[string]any{"device_name": deviceName})
+		devices, _, err := d.client.Devices.List(ctx, &xelon.DeviceListOptions{Search: deviceName})
+		if err != nil {
+			response.Diagnostics.AddError("Unable to search devices by name", err.Error())
+			return
+		}
+		tflog.Debug(ctx, "Got devices", map[string]any{"data": devices})
+
+		// search is a partial match, so only keep devices with exactly the same name
+		var filteredDevices []xelon.Device
+		for _, filteredDevice := range devices {
+			if filteredDevice.DisplayName == deviceName {
+				filteredDevices = append(filteredDevices, filteredDevice)
+			}
+		}
+
+		if len(filteredDevices) == 0 {
+			response.Diagnostics.AddError("No search results", "Please refine your search.")
+			return
+		}
+		if len(filteredDevices) > 1 {
+			response.Diagnostics.AddError(
+				"Too many search results",
+				fmt.Sprintf("Please refine your search to be more specific. Found %v devices.", len(filteredDevices)),
+			)
+			return
+		}
+
+		// enrich data because not all fields are exposed via list API
+		tflog.Debug(ctx, "Getting device", map[string]any{"device_id": filteredDevices[0].ID})
+		device, _, err = d.client.Devices.Get(ctx, filteredDevices[0].ID)
+		if err != nil {
+			response.Diagnostics.AddError("Unable to get device", err.Error())
+			return
+		}
+		tflog.Debug(ctx, "Got device", map[string]any{"data": device})
+	}
+
+	tflog.Debug(ctx, "Getting device network info", map[string]any{"device_id": device.ID})
+	deviceNetworks, _, err := d.client.Devices.GetNetworkInfo(ctx, device.ID)
+	if err != nil {
+		response.Diagnostics.AddError("Unable to get device network info", err.Error())
+		return
+	}
+	tflog.Debug(ctx, "Got device network info", map[string]any{"data": deviceNetworks})
+
+	// map response body to attributes
+	data.fromAPI(device, deviceNetworks)
+
+	diags = response.State.Set(ctx, &data)
+	response.Diagnostics.Append(diags...)
+}
+
+func (m *deviceDataSourceModel) fromAPI(device *xelon.Device, deviceNetworks []xelon.DeviceNetwork) {
+	storages := slices.Clone(device.Storages)
+	slices.SortFunc(storages, func(first, second xelon.DeviceStorage) int {
+		return first.UnitNumber - second.UnitNumber
+	})
+	disks := make([]deviceDiskDataSourceModel, 0, len(storages))
+	for _, storage := range storages {
+		disks = append(disks, deviceDiskDataSourceModel{
+			ID:         types.StringValue(storage.ID),
+			Size:       types.Int64Value(int64(storage.Size)),
+			UnitNumber: types.Int64Value(int64(storage.UnitNumber)),
+		})
+	}
+
+	networks := make([]deviceNetworkDataSourceModel, 0, len(deviceNetworks))
+	for _, deviceNetwork := range deviceNetworks {
+		network := deviceNetworkDataSourceModel{
+			Connected: types.BoolValue(deviceNetwork.Connected),
+			ID:        types.StringValue(deviceNetwork.ID),
+			IPAddress: types.StringNull(),
+		}
+		for _, ipAddress := range deviceNetwork.IPAddresses {
+			if ipAddress.Is4() {
+				network.IPAddress = types.StringValue(ipAddress.String())
+				break
+			}
+		}
+		networks = append(networks, network)
+	}
+
+	m.CPUCoreCount = types.Int64Value(int64(device.CPUCores))
+	m.Disks = disks
+	m.DisplayName = types.StringValue(device.DisplayName)
+	m.Hostname = types.StringValue(device.HostName)
+	m.ID = types.StringValue(device.ID)
+	m.Memory = types.Int64Value(int64(device.RAM))
+	m.Networks = networks
+	m.PowerState = types.StringValue(devicePowerState(device))
+	m.TenantID = types.StringNull()
+	if device.Tenant != nil {
+		m.TenantID = types.StringValue(device.Tenant.ID)
+	}
+}
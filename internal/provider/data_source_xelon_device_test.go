@@ -0,0 +1,213 @@
+package provider
+
+import (
+	"net/netip"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Xelon-AG/xelon-sdk-go/xelon"
+)
+
+func TestDataSourceXelonDevice_Model_FromAPI(t *testing.T) {
+	device := &xelon.Device{
+		CPUCores:    2,
+		DisplayName: "web-1",
+		HostName:    "web-1.example.com",
+		ID:          "device-id",
+		PoweredOn:   true,
+		RAM:         4,
+		Storages: []xelon.DeviceStorage{
+			{ID: "swap-disk-id", Size: 2, UnitNumber: 1},
+			{ID: "disk-id", Size: 20, UnitNumber: 0},
+		},
+		Tenant: &xelon.Tenant{ID: "tenant-id"},
+	}
+	deviceNetworks := []xelon.DeviceNetwork{
+		{
+			Connected:   true,
+			ID:          "network-id",
+			IPAddresses: xelon.DeviceNetworkIPAddresses{netip.MustParseAddr("fd00::1"), netip.MustParseAddr("10.0.0.25")},
+		},
+		{
+			Connected: false,
+			ID:        "disconnected-network-id",
+		},
+	}
+	expected := deviceDataSourceModel{
+		CPUCoreCount: types.Int64Value(2),
+		Disks: []deviceDiskDataSourceModel{
+			{ID: types.StringValue("disk-id"), Size: types.Int64Value(20), UnitNumber: types.Int64Value(0)},
+			{ID: types.StringValue("swap-disk-id"), Size: types.Int64Value(2), UnitNumber: types.Int64Value(1)},
+		},
+		DisplayName: types.StringValue("web-1"),
+		Hostname:    types.StringValue("web-1.example.com"),
+		ID:          types.StringValue("device-id"),
+		Memory:      types.Int64Value(4),
+		Networks: []deviceNetworkDataSourceModel{
+			{Connected: types.BoolValue(true), ID: types.StringValue("network-id"), IPAddress: types.StringValue("10.0.0.25")},
+			{Connected: types.BoolValue(false), ID: types.StringValue("disconnected-network-id"), IPAddress: types.StringNull()},
+		},
+		PowerState: types.StringValue("on"),
+		TenantID:   types.StringValue("tenant-id"),
+	}
+
+	var actual deviceDataSourceModel
+	actual.fromAPI(device, deviceNetworks)
+
+	assert.Equal(t, expected, actual)
+	// API response must not be reordered
+	assert.Equal(t, "swap-disk-id", device.Storages[0].ID)
+}
+
+func TestDataSourceXelonDevices_MatchesFilters(t *testing.T) {
+	device := &xelon.Device{DisplayName: "Web-1", Tenant: &xelon.Tenant{ID: "tenant-id"}}
+
+	assert.True(t, deviceMatchesFilters(device, "", ""))
+	assert.True(t, deviceMatchesFilters(device, "web", ""))
+	assert.True(t, deviceMatchesFilters(device, "WEB-1", "tenant-id"))
+	assert.False(t, deviceMatchesFilters(device, "db", ""))
+	assert.False(t, deviceMatchesFilters(device, "", "other-tenant-id"))
+	assert.False(t, deviceMatchesFilters(&xelon.Device{DisplayName: "web-1"}, "", "tenant-id"))
+}
+
+func TestDataSourceXelonDevice(t *testing.T) {
+	server := newFakeXelonServer(t)
+	server.Mutate(func(s *fakeXelonServer) {
+		s.devices["1"] = &xelon.Device{
+			CPUCores:    2,
+			DisplayName: "web-1",
+			HostName:    "web-1",
+			ID:          "1",
+			PoweredOn:   true,
+			RAM:         4,
+			Storages:    []xelon.DeviceStorage{{ID: "disk-1", Size: 20}},
+			Tenant:      &xelon.Tenant{ID: "tenant-123"},
+		}
+		s.devices["2"] = &xelon.Device{
+			DisplayName: "web-10",
+			HostName:    "web-10",
+			ID:          "2",
+			Tenant:      &xelon.Tenant{ID: "tenant-456"},
+		}
+		s.deviceNetworks["1"] = []xelon.DeviceNetwork{{Connected: true, ID: "network-1"}}
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + `
+data "xelon_device" "by_id" {
+  id = "1"
+}
+
+data "xelon_device" "by_name" {
+  display_name = "web-1"
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.xelon_device.by_id",
+						tfjsonpath.New("display_name"),
+						knownvalue.StringExact("web-1"),
+					),
+					statecheck.ExpectKnownValue(
+						"data.xelon_device.by_id",
+						tfjsonpath.New("power_state"),
+						knownvalue.StringExact("on"),
+					),
+					statecheck.ExpectKnownValue(
+						"data.xelon_device.by_id",
+						tfjsonpath.New("disks").AtSliceIndex(0).AtMapKey("size"),
+						knownvalue.Int64Exact(20),
+					),
+					statecheck.ExpectKnownValue(
+						"data.xelon_device.by_id",
+						tfjsonpath.New("networks").AtSliceIndex(0).AtMapKey("id"),
+						knownvalue.StringExact("network-1"),
+					),
+					statecheck.ExpectKnownValue(
+						"data.xelon_device.by_name",
+						tfjsonpath.New("id"),
+						knownvalue.StringExact("1"),
+					),
+				},
+			},
+			{
+				Config: server.ProviderConfig() + `
+data "xelon_device" "test" {
+  id           = "1"
+  display_name = "web-10"
+}
+`,
+				ExpectError: regexp.MustCompile(`Ambiguous search result`),
+			},
+			{
+				Config: server.ProviderConfig() + `
+data "xelon_device" "test" {
+  display_name = "db-1"
+}
+`,
+				ExpectError: regexp.MustCompile(`No search results`),
+			},
+		},
+	})
+}
+
+func TestDataSourceXelonDevices(t *testing.T) {
+	server := newFakeXelonServer(t)
+	server.Mutate(func(s *fakeXelonServer) {
+		s.devices["1"] = &xelon.Device{DisplayName: "web-1", ID: "1", Tenant: &xelon.Tenant{ID: "tenant-123"}}
+		s.devices["2"] = &xelon.Device{DisplayName: "web-2", ID: "2", Tenant: &xelon.Tenant{ID: "tenant-456"}}
+		s.devices["3"] = &xelon.Device{DisplayName: "db-1", ID: "3", Tenant: &xelon.Tenant{ID: "tenant-123"}}
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + `
+data "xelon_devices" "all" {}
+
+data "xelon_devices" "web" {
+  display_name = "web"
+}
+
+data "xelon_devices" "tenant" {
+  tenant_id = "tenant-123"
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.xelon_devices.all",
+						tfjsonpath.New("devices"),
+						knownvalue.ListSizeExact(3),
+					),
+					statecheck.ExpectKnownValue(
+						"data.xelon_devices.web",
+						tfjsonpath.New("devices"),
+						knownvalue.ListExact([]knownvalue.Check{
+							knownvalue.ObjectPartial(map[string]knownvalue.Check{"id": knownvalue.StringExact("1")}),
+							knownvalue.ObjectPartial(map[string]knownvalue.Check{"id": knownvalue.StringExact("2")}),
+						}),
+					),
+					statecheck.ExpectKnownValue(
+						"data.xelon_devices.tenant",
+						tfjsonpath.New("devices"),
+						knownvalue.ListExact([]knownvalue.Check{
+							knownvalue.ObjectPartial(map[string]knownvalue.Check{"id": knownvalue.StringExact("1")}),
+							knownvalue.ObjectPartial(map[string]knownvalue.Check{"id": knownvalue.StringExact("3")}),
+						}),
+					),
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,180 @@
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/Xelon-AG/xelon-sdk-go/xelon"
+)
+
+var (
+	_ datasource.DataSource              = (*devicesDataSource)(nil)
+	_ datasource.DataSourceWithConfigure = (*devicesDataSource)(nil)
+)
+
+// devicesDataSource is the devices data source implementation.
+type devicesDataSource struct {
+	client *xelon.Client
+}
+
+// devicesDataSourceModel maps the devices datasource schema data.
+type devicesDataSourceModel struct {
+	Devices     []devicesItemDataSourceModel `tfsdk:"devices"`
+	DisplayName types.String                 `tfsdk:"display_name"`
+	TenantID    types.String                 `tfsdk:"tenant_id"`
+}
+
+type devicesItemDataSourceModel struct {
+	CPUCoreCount types.Int64  `tfsdk:"cpu_core_count"`
+	DisplayName  types.String `tfsdk:"display_name"`
+	Hostname     types.String `tfsdk:"hostname"`
+	ID           types.String `tfsdk:"id"`
+	Memory       types.Int64  `tfsdk:"memory"`
+	PowerState   types.String `tfsdk:"power_state"`
+	TenantID     types.String `tfsdk:"tenant_id"`
+}
+
+func NewDevicesDataSource() datasource.DataSource {
+	return &devicesDataSource{}
+}
+
+func (d *devicesDataSource) Metadata(_ context.Context, _ datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "xelon_devices"
+}
+
+func (d *devicesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		MarkdownDescription: `
+The devices data source provides a list of existing devices, optionally filtered by tenant or name.
+`,
+		Attributes: map[string]schema.Attribute{
+			"devices": schema.ListNestedAttribute{
+				MarkdownDescription: "The devices matching the filters.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"cpu_core_count": schema.Int64Attribute{
+							MarkdownDescription: "The number of CPU cores allocated to the device.",
+							Computed:            true,
+						},
+						"display_name": schema.StringAttribute{
+							MarkdownDescription: "The name of the device.",
+							Computed:            true,
+						},
+						"hostname": schema.StringAttribute{
+							MarkdownDescription: "The hostname of the device.",
+							Computed:            true,
+						},
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the device.",
+							Computed:            true,
+						},
+						"memory": schema.Int64Attribute{
+							MarkdownDescription: "The amount of RAM in GB allocated to the device.",
+							Computed:            true,
+						},
+						"power_state": schema.StringAttribute{
+							MarkdownDescription: "The power state of the device (`on` or `off`).",
+							Computed:            true,
+						},
+						"tenant_id": schema.StringAttribute{
+							MarkdownDescription: "The tenant ID to whom the device belongs.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"display_name": schema.StringAttribute{
+				MarkdownDescription: "If set, only devices whose name contains this value (case-insensitive) are returned.",
+				Optional:            true,
+			},
+			"tenant_id": schema.StringAttribute{
+				MarkdownDescription: "If set, only devices belonging to this tenant are returned.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (d *devicesDataSource) Configure(_ context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	client, ok := request.ProviderData.(*xelon.Client)
+	if !ok {
+		response.Diagnostics.AddError(
+			"Unconfigured Xelon client",
+			"Please report this issue to the provider developers.",
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *devicesDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data devicesDataSourceModel
+
+	diags := request.Config.Get(ctx, &data)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Getting devices", map[string]any{
+		"display_name": data.DisplayName.ValueString(),
+		"tenant_id":    data.TenantID.ValueString(),
+	})
+	var filteredDevices []xelon.Device
+	devices, errf := d.client.Devices.All(ctx, &xelon.ListOptions{PerPage: 100})
+	for device := range devices {
+		if deviceMatchesFilters(&device, data.DisplayName.ValueString(), data.TenantID.ValueString()) {
+			filteredDevices = append(filteredDevices, device)
+		}
+	}
+	if err := errf(); err != nil {
+		response.Diagnostics.AddError("Unable to list devices", err.Error())
+		return
+	}
+	tflog.Debug(ctx, "Got devices", map[string]any{"device_count": len(filteredDevices)})
+
+	// map response body to attributes
+	items := make([]devicesItemDataSourceModel, 0, len(filteredDevices))
+	for _, device := range filteredDevices {
+		item := devicesItemDataSourceModel{
+			CPUCoreCount: types.Int64Value(int64(device.CPUCores)),
+			DisplayName:  types.StringValue(device.DisplayName),
+			Hostname:     types.StringValue(device.HostName),
+			ID:           types.StringValue(device.ID),
+			Memory:       types.Int64Value(int64(device.RAM)),
+			PowerState:   types.StringValue(devicePowerState(&device)),
+			TenantID:     types.StringNull(),
+		}
+		if device.Tenant != nil {
+			item.TenantID = types.StringValue(device.Tenant.ID)
+		}
+		items = append(items, item)
+	}
+	data.Devices = items
+
+	diags = response.State.Set(ctx, &data)
+	response.Diagnostics.Append(diags...)
+}
+
+// deviceMatchesFilters reports whether device matches the optional name
+// (case-insensitive substring) and tenant filters. Empty filters match all.
+func deviceMatchesFilters(device *xelon.Device, displayName, tenantID string) bool {
+	if displayName != "" && !strings.Contains(strings.ToLower(device.DisplayName), strings.ToLower(displayName)) {
+		return false
+	}
+	if tenantID != "" && (device.Tenant == nil || device.Tenant.ID != tenantID) {
+		return false
+	}
+	return true
+}
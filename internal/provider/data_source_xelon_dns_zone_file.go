@@ -0,0 +1,222 @@
+package provider
+
+import (
+	"cmp"
+	"context"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/Xelon-AG/terraform-provider-xelon/internal/provider/helper"
+	"github.com/Xelon-AG/xelon-sdk-go/xelon"
+)
+
+var (
+	_ datasource.DataSource              = (*dnsZoneFileDataSource)(nil)
+	_ datasource.DataSourceWithConfigure = (*dnsZoneFileDataSource)(nil)
+)
+
+// dnsZoneFileDataSource is the DNS zone file data source implementation.
+type dnsZoneFileDataSource struct {
+	client *xelon.Client
+}
+
+// dnsZoneFileDataSourceModel maps the DNS zone file datasource schema data.
+type dnsZoneFileDataSourceModel struct {
+	Content types.String `tfsdk:"content"`
+	Name    types.String `tfsdk:"name"`
+	ZoneID  types.String `tfsdk:"zone_id"`
+}
+
+func NewDNSZoneFileDataSource() datasource.DataSource {
+	return &dnsZoneFileDataSource{}
+}
+
+func (d *dnsZoneFileDataSource) Metadata(_ context.Context, _ datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "xelon_dns_zone_file"
+}
+
+func (d *dnsZoneFileDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		MarkdownDescription: `
+The DNS zone file data source renders an existing DNS zone, including its SOA settings and all records, in RFC 1035 zone file format.
+`,
+		Attributes: map[string]schema.Attribute{
+			"content": schema.StringAttribute{
+				MarkdownDescription: "The zone file content.",
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the DNS zone.",
+				Computed:            true,
+			},
+			"zone_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the DNS zone.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (d *dnsZoneFileDataSource) Configure(_ context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	client, ok := request.ProviderData.(*xelon.Client)
+	if !ok {
+		response.Diagnostics.AddError(
+			"Unconfigured Xelon client",
+			"Please report this issue to the provider developers.",
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *dnsZoneFileDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data dnsZoneFileDataSourceModel
+
+	diags := request.Config.Get(ctx, &data)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	zoneID := data.ZoneID.ValueString()
+	tflog.Debug(ctx, "Getting DNS zone", map[string]any{"zone_id": zoneID})
+	dnsZone, resp, err := d.client.Domains.GetZone(ctx, zoneID)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			response.Diagnostics.AddError("No search results", "Please refine your search.")
+			return
+		}
+		response.Diagnostics.AddError("Unable to get DNS zone", err.Error())
+		return
+	}
+	tflog.Debug(ctx, "Got DNS zone", map[string]any{"data": dnsZone})
+
+	tflog.Debug(ctx, "Getting DNS SOA settings", map[string]any{"zone_id": zoneID})
+	soa, _, err := d.client.Domains.GetSOA(ctx, zoneID)
+	if err != nil {
+		response.Diagnostics.AddError("Unable to get DNS SOA settings", err.Error())
+		return
+	}
+	tflog.Debug(ctx, "Got DNS SOA settings", map[string]any{"data": soa})
+
+	tflog.Debug(ctx, "Getting DNS records", map[string]any{"zone_id": zoneID})
+	records, _, err := d.client.Domains.ListRecords(ctx, zoneID)
+	if err != nil {
+		response.Diagnostics.AddError("Unable to get DNS records", err.Error())
+		return
+	}
+	tflog.Debug(ctx, "Got DNS records", map[string]any{"record_count": len(records)})
+
+	data.Content = types.StringValue(helper.RenderZoneFile(dnsZone.Name, buildDNSZoneFileRecords(soa, records)))
+	data.Name = types.StringValue(dnsZone.Name)
+
+	diags = response.State.Set(ctx, &data)
+	response.Diagnostics.Append(diags...)
+}
+
+// buildDNSZoneFileRecords converts the SOA settings and records of a DNS zone
+// into zone file records, starting with the SOA record followed by the other
+// records ordered by name and type.
+func buildDNSZoneFileRecords(soa *xelon.DNSSOA, records []xelon.DNSRecord) []helper.ZoneFileRecord {
+	zoneFileRecords := make([]helper.ZoneFileRecord, 0, len(records))
+	for _, record := range records {
+		// the SOA record is rendered from the SOA settings
+		if record.Type == "SOA" {
+			continue
+		}
+
+		name := record.Host
+		if name == "" {
+			name = "@"
+		}
+		zoneFileRecords = append(zoneFileRecords, helper.ZoneFileRecord{
+			Data: dnsZoneFileRecordData(&record),
+			Name: name,
+			TTL:  int64(record.TTL),
+			Type: string(record.Type),
+		})
+	}
+	slices.SortStableFunc(zoneFileRecords, func(first, second helper.ZoneFileRecord) int {
+		// records of the zone apex first
+		if first.Name != second.Name && (first.Name == "@" || second.Name == "@") {
+			if first.Name == "@" {
+				return -1
+			}
+			return 1
+		}
+		return cmp.Or(cmp.Compare(first.Name, second.Name), cmp.Compare(first.Type, second.Type))
+	})
+
+	soaRecord := helper.ZoneFileRecord{
+		Data: []string{
+			soa.PrimaryNS,
+			dnsZoneFileMailbox(soa.AdminEmail),
+			strconv.Itoa(soa.SerialNumber),
+			strconv.Itoa(soa.Refresh),
+			strconv.Itoa(soa.Retry),
+			strconv.Itoa(soa.Expire),
+			strconv.Itoa(soa.TTL),
+		},
+		Name: "@",
+		TTL:  int64(soa.TTL),
+		Type: "SOA",
+	}
+
+	return slices.Insert(zoneFileRecords, 0, soaRecord)
+}
+
+// dnsZoneFileRecordData splits the record value into its RDATA fields.
+func dnsZoneFileRecordData(record *xelon.DNSRecord) []string {
+	switch record.Type {
+	case xelon.DNSRecordTypeCAA, xelon.DNSRecordTypeMX, xelon.DNSRecordTypeSRV:
+		content, ok := parseDNSRecordContent(string(record.Type), record.Record)
+		if !ok {
+			return strings.Fields(record.Record)
+		}
+		switch record.Type {
+		case xelon.DNSRecordTypeCAA:
+			return []string{strconv.FormatInt(content.Flags, 10), content.Tag, content.Content}
+		case xelon.DNSRecordTypeMX:
+			return []string{strconv.FormatInt(content.Priority, 10), content.Content}
+		default:
+			return []string{
+				strconv.FormatInt(content.Priority, 10),
+				strconv.FormatInt(content.Weight, 10),
+				strconv.FormatInt(content.Port, 10),
+				content.Content,
+			}
+		}
+	case xelon.DNSRecordTypeTXT:
+		return []string{record.Record}
+	default:
+		return strings.Fields(record.Record)
+	}
+}
+
+// dnsZoneFileMailbox converts an email address into the domain name form used
+// by the SOA record, e.g. "first.last@example.com" into "first\.last.example.com".
+func dnsZoneFileMailbox(email string) string {
+	local, domain, found := strings.Cut(email, "@")
+	if !found {
+		return email
+	}
+
+	return strings.ReplaceAll(local, ".", `\.`) + "." + domain
+}
@@ -0,0 +1,117 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Xelon-AG/terraform-provider-xelon/internal/provider/helper"
+	"github.com/Xelon-AG/xelon-sdk-go/xelon"
+)
+
+func TestDataSourceXelonDNSZoneFile_BuildRecords(t *testing.T) {
+	soa := &xelon.DNSSOA{
+		AdminEmail:   "support@cloudns.net",
+		Expire:       1209600,
+		PrimaryNS:    "ns1.xdns.cloud",
+		Refresh:      7200,
+		Retry:        1800,
+		SerialNumber: 2024010101,
+		TTL:          3600,
+	}
+	records := []xelon.DNSRecord{
+		{Host: "www", Type: xelon.DNSRecordTypeA, Record: "203.0.113.10", TTL: 300},
+		{Host: "_sip._tcp", Type: xelon.DNSRecordTypeSRV, Record: "10 5 5060 sip.example.com", TTL: 3600},
+		{Host: "@", Type: xelon.DNSRecordType("SOA"), Record: "ns1.xdns.cloud support.cloudns.net 1 7200 1800 1209600 3600", TTL: 3600},
+		{Host: "", Type: xelon.DNSRecordTypeCAA, Record: `0 issue "letsencrypt.org"`, TTL: 3600},
+		{Host: "@", Type: xelon.DNSRecordTypeMX, Record: "10 mail.example.com", TTL: 3600},
+		{Host: "@", Type: xelon.DNSRecordTypeTXT, Record: "v=spf1 mx -all", TTL: 3600},
+	}
+
+	zoneFileRecords := buildDNSZoneFileRecords(soa, records)
+
+	assert.Equal(t, []helper.ZoneFileRecord{
+		{Data: []string{"ns1.xdns.cloud", "support.cloudns.net", "2024010101", "7200", "1800", "1209600", "3600"}, Name: "@", TTL: 3600, Type: "SOA"},
+		{Data: []string{"0", "issue", "letsencrypt.org"}, Name: "@", TTL: 3600, Type: "CAA"},
+		{Data: []string{"10", "mail.example.com"}, Name: "@", TTL: 3600, Type: "MX"},
+		{Data: []string{"v=spf1 mx -all"}, Name: "@", TTL: 3600, Type: "TXT"},
+		{Data: []string{"10", "5", "5060", "sip.example.com"}, Name: "_sip._tcp", TTL: 3600, Type: "SRV"},
+		{Data: []string{"203.0.113.10"}, Name: "www", TTL: 300, Type: "A"},
+	}, zoneFileRecords)
+}
+
+func TestDataSourceXelonDNSZoneFile_Mailbox(t *testing.T) {
+	testCases := map[string]string{
+		"hostmaster@example.com": "hostmaster.example.com",
+		"first.last@example.com": `first\.last.example.com`,
+		"hostmaster.example.com": "hostmaster.example.com",
+	}
+
+	for email, want := range testCases {
+		t.Run(email, func(t *testing.T) {
+			assert.Equal(t, want, dnsZoneFileMailbox(email))
+		})
+	}
+}
+
+func TestDataSourceXelonDNSZoneFile(t *testing.T) {
+	server := newFakeXelonServer(t)
+	server.Mutate(func(s *fakeXelonServer) {
+		s.dnsZones["1"] = &xelon.DNSZone{ID: "1", Name: "example.com"}
+		s.dnsSOAs["1"] = &xelon.DNSSOA{
+			AdminEmail:   "support@cloudns.net",
+			Expire:       1209600,
+			PrimaryNS:    "ns1.xdns.cloud",
+			Refresh:      7200,
+			Retry:        1800,
+			SerialNumber: 1,
+			TTL:          3600,
+		}
+		s.dnsRecords["1"] = []xelon.DNSRecord{
+			{ID: 1, Host: "www", Type: xelon.DNSRecordTypeA, Record: "203.0.113.10", TTL: 300},
+			{ID: 2, Host: "@", Type: xelon.DNSRecordTypeMX, Record: "10 mail.example.com", TTL: 3600},
+		}
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + `
+data "xelon_dns_zone_file" "test" {
+  zone_id = "1"
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.xelon_dns_zone_file.test",
+						tfjsonpath.New("name"),
+						knownvalue.StringExact("example.com"),
+					),
+					statecheck.ExpectKnownValue(
+						"data.xelon_dns_zone_file.test",
+						tfjsonpath.New("content"),
+						knownvalue.StringExact(`$ORIGIN example.com.
+@   3600 IN SOA ns1.xdns.cloud. support.cloudns.net. 1 7200 1800 1209600 3600
+@   3600 IN MX  10 mail.example.com.
+www 300  IN A   203.0.113.10
+`),
+					),
+				},
+			},
+			{
+				Config: server.ProviderConfig() + `
+data "xelon_dns_zone_file" "test" {
+  zone_id = "42"
+}
+`,
+				ExpectError: regexp.MustCompile(`No search results`),
+			},
+		},
+	})
+}
@@ -41,6 +41,9 @@ func (d *kubernetesClusterDataSource) Schema(_ context.Context, _ datasource.Sch
 		MarkdownDescription: `
 The Kubernetes cluster data source provides information about an existing Xelon Kubernetes (XKS) cluster.
 XKS is a Kubernetes service with a fully managed control plane and high availability.
+
+~> The kubeconfig and talosconfig are stored in plain text in the state. Use the ` + "`xelon_kubernetes_cluster_kubeconfig`" + ` and
+` + "`xelon_kubernetes_cluster_talosconfig`" + ` ephemeral resources to use them without persisting them.
 `,
 		Attributes: map[string]schema.Attribute{
 			"kubeconfig_raw": schema.StringAttribute{
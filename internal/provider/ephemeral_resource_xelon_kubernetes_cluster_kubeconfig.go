@@ -0,0 +1,131 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/Xelon-AG/terraform-provider-xelon/internal/provider/helper"
+	"github.com/Xelon-AG/xelon-sdk-go/xelon"
+)
+
+var (
+	_ ephemeral.EphemeralResource              = (*kubernetesClusterKubeconfigEphemeralResource)(nil)
+	_ ephemeral.EphemeralResourceWithConfigure = (*kubernetesClusterKubeconfigEphemeralResource)(nil)
+)
+
+// kubernetesClusterKubeconfigEphemeralResource is the Kubernetes cluster kubeconfig ephemeral resource implementation.
+type kubernetesClusterKubeconfigEphemeralResource struct {
+	client *xelon.Client
+}
+
+// kubernetesClusterKubeconfigEphemeralResourceModel maps the Kubernetes cluster kubeconfig ephemeral resource schema data.
+type kubernetesClusterKubeconfigEphemeralResourceModel struct {
+	ClientCertificate    types.String `tfsdk:"client_certificate"`
+	ClientKey            types.String `tfsdk:"client_key"`
+	ClusterCACertificate types.String `tfsdk:"cluster_ca_certificate"`
+	Host                 types.String `tfsdk:"host"`
+	KubeconfigRaw        types.String `tfsdk:"kubeconfig_raw"`
+	KubernetesClusterID  types.String `tfsdk:"kubernetes_cluster_id"`
+}
+
+func NewKubernetesClusterKubeconfigEphemeralResource() ephemeral.EphemeralResource {
+	return &kubernetesClusterKubeconfigEphemeralResource{}
+}
+
+func (r *kubernetesClusterKubeconfigEphemeralResource) Metadata(_ context.Context, _ ephemeral.MetadataRequest, response *ephemeral.MetadataResponse) {
+	response.TypeName = "xelon_kubernetes_cluster_kubeconfig"
+}
+
+func (r *kubernetesClusterKubeconfigEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		MarkdownDescription: `
+The Kubernetes cluster kubeconfig ephemeral resource provides the credentials of an existing Xelon Kubernetes (XKS) cluster
+without persisting them in the plan or state, e.g. to configure the kubernetes and helm providers.
+`,
+		Attributes: map[string]schema.Attribute{
+			"client_certificate": schema.StringAttribute{
+				MarkdownDescription: "The PEM encoded client certificate for authenticating to the Kubernetes API server.",
+				Computed:            true,
+			},
+			"client_key": schema.StringAttribute{
+				MarkdownDescription: "The PEM encoded private key of the client certificate.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"cluster_ca_certificate": schema.StringAttribute{
+				MarkdownDescription: "The PEM encoded CA certificate of the Kubernetes API server.",
+				Computed:            true,
+			},
+			"host": schema.StringAttribute{
+				MarkdownDescription: "The URL of the Kubernetes API server.",
+				Computed:            true,
+			},
+			"kubeconfig_raw": schema.StringAttribute{
+				MarkdownDescription: "Raw Kubernetes config for this Kubernetes cluster to be used by " +
+					"[kubectl](https://kubernetes.io/docs/reference/kubectl/overview/) and other compatible tools.",
+				Computed:  true,
+				Sensitive: true,
+			},
+			"kubernetes_cluster_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the Kubernetes cluster.",
+				Required:            true,
+			},
+		},
+	}
+}
+
+func (r *kubernetesClusterKubeconfigEphemeralResource) Configure(_ context.Context, request ephemeral.ConfigureRequest, response *ephemeral.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	client, ok := request.ProviderData.(*xelon.Client)
+	if !ok {
+		response.Diagnostics.AddError(
+			"Unconfigured Xelon client",
+			"Please report this issue to the provider developers.",
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *kubernetesClusterKubeconfigEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data kubernetesClusterKubeconfigEphemeralResourceModel
+	diags := request.Config.Get(ctx, &data)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	kubernetesClusterID := data.KubernetesClusterID.ValueString()
+
+	tflog.Debug(ctx, "Getting kubeconfig for cluster", map[string]any{"kubernetes_cluster_id": kubernetesClusterID})
+	kubeconfigRaw, _, err := r.client.Kubernetes.GetKubeconfig(ctx, kubernetesClusterID)
+	if err != nil {
+		response.Diagnostics.AddError("Unable to get kubeconfig", err.Error())
+		return
+	}
+	tflog.Debug(ctx, "Got kubeconfig for cluster", map[string]any{"kubernetes_cluster_id": kubernetesClusterID})
+
+	kubeconfig, err := helper.ParseKubeconfig(kubeconfigRaw)
+	if err != nil {
+		response.Diagnostics.AddError("Unable to parse kubeconfig", err.Error())
+		return
+	}
+
+	// map response body to attributes
+	data.ClientCertificate = types.StringValue(kubeconfig.ClientCertificate)
+	data.ClientKey = types.StringValue(kubeconfig.ClientKey)
+	data.ClusterCACertificate = types.StringValue(kubeconfig.ClusterCACertificate)
+	data.Host = types.StringValue(kubeconfig.Host)
+	data.KubeconfigRaw = types.StringValue(kubeconfigRaw)
+
+	diags = response.Result.Set(ctx, &data)
+	response.Diagnostics.Append(diags...)
+}
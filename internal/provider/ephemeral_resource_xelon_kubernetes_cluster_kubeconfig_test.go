@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Xelon-AG/xelon-sdk-go/xelon"
+)
+
+func TestEphemeralResourceXelonKubernetesClusterKubeconfig_Schema_Sensitive(t *testing.T) {
+	response := &ephemeral.SchemaResponse{}
+	NewKubernetesClusterKubeconfigEphemeralResource().Schema(context.Background(), ephemeral.SchemaRequest{}, response)
+	require.False(t, response.Diagnostics.HasError())
+
+	for name, wantSensitive := range map[string]bool{
+		"client_certificate":     false,
+		"client_key":             true,
+		"cluster_ca_certificate": false,
+		"host":                   false,
+		"kubeconfig_raw":         true,
+	} {
+		attribute, ok := response.Schema.Attributes[name].(schema.StringAttribute)
+		require.True(t, ok, name)
+		assert.True(t, attribute.Computed, name)
+		assert.Equal(t, wantSensitive, attribute.Sensitive, name)
+	}
+}
+
+func TestEphemeralResourceXelonKubernetesClusterKubeconfig_Open(t *testing.T) {
+	server := newFakeXelonServer(t)
+	server.Mutate(func(s *fakeXelonServer) {
+		s.kubernetesClusters["cluster-1"] = &xelon.KubernetesCluster{ID: "cluster-1", Name: "xks", Status: "ready"}
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"xelon": testAccProtoV6ProviderFactories["xelon"],
+			"echo":  echoprovider.NewProviderServer(),
+		},
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + testEphemeralResourceXelonKubernetesClusterKubeconfigConfig("cluster-1"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"echo.test",
+						tfjsonpath.New("data").AtMapKey("host"),
+						knownvalue.StringExact("https://cluster-1.xks.example.com:6443"),
+					),
+					statecheck.ExpectKnownValue(
+						"echo.test",
+						tfjsonpath.New("data").AtMapKey("cluster_ca_certificate"),
+						knownvalue.StringExact("CA CERTIFICATE cluster-1"),
+					),
+				},
+			},
+			{
+				Config:      server.ProviderConfig() + testEphemeralResourceXelonKubernetesClusterKubeconfigConfig("missing"),
+				ExpectError: regexp.MustCompile("Unable to get kubeconfig"),
+			},
+		},
+	})
+}
+
+func testEphemeralResourceXelonKubernetesClusterKubeconfigConfig(kubernetesClusterID string) string {
+	return fmt.Sprintf(`
+ephemeral "xelon_kubernetes_cluster_kubeconfig" "test" {
+  kubernetes_cluster_id = %q
+}
+
+provider "echo" {
+  data = ephemeral.xelon_kubernetes_cluster_kubeconfig.test
+}
+
+resource "echo" "test" {}
+`, kubernetesClusterID)
+}
@@ -0,0 +1,146 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/Xelon-AG/terraform-provider-xelon/internal/provider/helper"
+	"github.com/Xelon-AG/xelon-sdk-go/xelon"
+)
+
+var (
+	_ ephemeral.EphemeralResource              = (*kubernetesClusterTalosconfigEphemeralResource)(nil)
+	_ ephemeral.EphemeralResourceWithConfigure = (*kubernetesClusterTalosconfigEphemeralResource)(nil)
+)
+
+// kubernetesClusterTalosconfigEphemeralResource is the Kubernetes cluster talosconfig ephemeral resource implementation.
+type kubernetesClusterTalosconfigEphemeralResource struct {
+	client *xelon.Client
+}
+
+// kubernetesClusterTalosconfigEphemeralResourceModel maps the Kubernetes cluster talosconfig ephemeral resource schema data.
+type kubernetesClusterTalosconfigEphemeralResourceModel struct {
+	CACertificate       types.String `tfsdk:"ca_certificate"`
+	ClientCertificate   types.String `tfsdk:"client_certificate"`
+	ClientKey           types.String `tfsdk:"client_key"`
+	Endpoints           types.List   `tfsdk:"endpoints"`
+	KubernetesClusterID types.String `tfsdk:"kubernetes_cluster_id"`
+	Nodes               types.List   `tfsdk:"nodes"`
+	TalosconfigRaw      types.String `tfsdk:"talosconfig_raw"`
+}
+
+func NewKubernetesClusterTalosconfigEphemeralResource() ephemeral.EphemeralResource {
+	return &kubernetesClusterTalosconfigEphemeralResource{}
+}
+
+func (r *kubernetesClusterTalosconfigEphemeralResource) Metadata(_ context.Context, _ ephemeral.MetadataRequest, response *ephemeral.MetadataResponse) {
+	response.TypeName = "xelon_kubernetes_cluster_talosconfig"
+}
+
+func (r *kubernetesClusterTalosconfigEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		MarkdownDescription: `
+The Kubernetes cluster talosconfig ephemeral resource provides the Talos API credentials of an existing Xelon Kubernetes (XKS)
+cluster without persisting them in the plan or state.
+`,
+		Attributes: map[string]schema.Attribute{
+			"ca_certificate": schema.StringAttribute{
+				MarkdownDescription: "The PEM encoded CA certificate of the Talos API.",
+				Computed:            true,
+			},
+			"client_certificate": schema.StringAttribute{
+				MarkdownDescription: "The PEM encoded client certificate for authenticating to the Talos API.",
+				Computed:            true,
+			},
+			"client_key": schema.StringAttribute{
+				MarkdownDescription: "The PEM encoded private key of the client certificate.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"endpoints": schema.ListAttribute{
+				MarkdownDescription: "The endpoints of the Talos API.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"kubernetes_cluster_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the Kubernetes cluster.",
+				Required:            true,
+			},
+			"nodes": schema.ListAttribute{
+				MarkdownDescription: "The nodes targeted by default by talosctl.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"talosconfig_raw": schema.StringAttribute{
+				MarkdownDescription: "Raw Talosconfig for this Kubernetes cluster to be used by " +
+					"[talosctl](https://docs.siderolabs.com/talos/latest/reference/cli).",
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func (r *kubernetesClusterTalosconfigEphemeralResource) Configure(_ context.Context, request ephemeral.ConfigureRequest, response *ephemeral.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	client, ok := request.ProviderData.(*xelon.Client)
+	if !ok {
+		response.Diagnostics.AddError(
+			"Unconfigured Xelon client",
+			"Please report this issue to the provider developers.",
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *kubernetesClusterTalosconfigEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data kubernetesClusterTalosconfigEphemeralResourceModel
+	diags := request.Config.Get(ctx, &data)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	kubernetesClusterID := data.KubernetesClusterID.ValueString()
+
+	tflog.Debug(ctx, "Getting talosconfig for cluster", map[string]any{"kubernetes_cluster_id": kubernetesClusterID})
+	talosconfigRaw, _, err := r.client.Kubernetes.GetTalosconfig(ctx, kubernetesClusterID)
+	if err != nil {
+		response.Diagnostics.AddError("Unable to get talosconfig", err.Error())
+		return
+	}
+	tflog.Debug(ctx, "Got talosconfig for cluster", map[string]any{"kubernetes_cluster_id": kubernetesClusterID})
+
+	talosconfig, err := helper.ParseTalosconfig(talosconfigRaw)
+	if err != nil {
+		response.Diagnostics.AddError("Unable to parse talosconfig", err.Error())
+		return
+	}
+
+	// map response body to attributes
+	data.CACertificate = types.StringValue(talosconfig.CACertificate)
+	data.ClientCertificate = types.StringValue(talosconfig.ClientCertificate)
+	data.ClientKey = types.StringValue(talosconfig.ClientKey)
+	endpoints, diags := types.ListValueFrom(ctx, types.StringType, talosconfig.Endpoints)
+	response.Diagnostics.Append(diags...)
+	nodes, diags := types.ListValueFrom(ctx, types.StringType, talosconfig.Nodes)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	data.Endpoints = endpoints
+	data.Nodes = nodes
+	data.TalosconfigRaw = types.StringValue(talosconfigRaw)
+
+	diags = response.Result.Set(ctx, &data)
+	response.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,451 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/Xelon-AG/xelon-sdk-go/xelon"
+)
+
+// exportTypeNames lists the list resources walked by Export. Objects are
+// listed before the ones depending on them, e.g. DNS zones before records.
+var exportTypeNames = []string{
+	"xelon_ssh_key",
+	"xelon_network",
+	"xelon_template",
+	"xelon_iso",
+	"xelon_device",
+	"xelon_persistent_storage",
+	"xelon_firewall",
+	"xelon_load_balancer",
+	"xelon_dns_zone",
+	"xelon_dns_record",
+	"xelon_object_storage_user",
+	"xelon_object_storage_bucket",
+}
+
+// exportChildTypeNames maps the resources without list resource, which are
+// read through their exported parent objects, to the type of the parent.
+var exportChildTypeNames = map[string]string{
+	"xelon_device_backup":                 "xelon_device",
+	"xelon_dns_soa":                       "xelon_dns_zone",
+	"xelon_firewall_forwarding_rule":      "xelon_firewall",
+	"xelon_load_balancer_forwarding_rule": "xelon_load_balancer",
+	"xelon_object_storage_access_key":     "xelon_object_storage_user",
+}
+
+// exportExcludedTypeNames lists the resources not exported by Export with the
+// reason.
+var exportExcludedTypeNames = map[string]string{
+	"xelon_dns_records":          "the records are exported as xelon_dns_record",
+	"xelon_kubernetes_cluster":   "the Xelon API does not list the Kubernetes clusters of a tenant",
+	"xelon_kubernetes_node_pool": "the node pools are only read through their Kubernetes cluster",
+}
+
+// ExportOptions configures Export.
+type ExportOptions struct {
+	// TenantID is the ID of the tenant to export. Defaults to the
+	// default_tenant_id setting or the tenant of the token.
+	TenantID string
+	// ObjectStorageRegion is assigned to the exported object storage users,
+	// as the Xelon API does not return it. Object storage users are skipped
+	// if empty.
+	ObjectStorageRegion string
+}
+
+// ExportedResource is an existing Xelon object read with its managed resource,
+// like Terraform does for an import block.
+type ExportedResource struct {
+	// TypeName is the managed resource type, e.g. xelon_device.
+	TypeName string
+	// DisplayName is the name of the object in Xelon HQ.
+	DisplayName string
+	// Schema is the schema of the managed resource.
+	Schema schema.Schema
+	// Identity is the resource identity to import the object with.
+	Identity *tfsdk.ResourceIdentity
+	// Resource is the resource state read from the Xelon API.
+	Resource *tfsdk.Resource
+}
+
+// Export reads all objects of a tenant supported by a list resource, their
+// child objects, and the tenant users. The provider is configured in read-only mode from the
+// environment and profile settings, like for an empty provider block.
+func Export(ctx context.Context, version string, options ExportOptions) ([]ExportedResource, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	p := New(version)().(*xelonProvider)
+	client := p.configureExport(ctx, &diags)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	tenantID := options.TenantID
+	if tenantID == "" {
+		tenantID = p.defaults.TenantID().ValueString()
+	}
+	if tenantID == "" {
+		tflog.Debug(ctx, "Getting current tenant")
+		tenant, _, err := client.Tenants.GetCurrent(ctx)
+		if err != nil {
+			diags.AddError("Unable to get current tenant", err.Error())
+			return nil, diags
+		}
+		tenantID = tenant.ID
+	}
+
+	newResources := make(map[string]func() resource.Resource)
+	for _, newResource := range p.Resources(ctx) {
+		metadataResponse := &resource.MetadataResponse{}
+		newResource().Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "xelon"}, metadataResponse)
+		newResources[metadataResponse.TypeName] = newResource
+	}
+	newListResources := make(map[string]func() list.ListResource)
+	for _, newListResource := range p.ListResources(ctx) {
+		metadataResponse := &resource.MetadataResponse{}
+		newListResource().Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "xelon"}, metadataResponse)
+		newListResources[metadataResponse.TypeName] = newListResource
+	}
+
+	var exported []ExportedResource
+	for _, typeName := range exportTypeNames {
+		var configs []map[string]string
+		switch typeName {
+		case "xelon_dns_record":
+			for _, zone := range exported {
+				if zone.TypeName != "xelon_dns_zone" {
+					continue
+				}
+				var zoneID types.String
+				diags.Append(zone.Resource.GetAttribute(ctx, path.Root("id"), &zoneID)...)
+				configs = append(configs, map[string]string{"zone_id": zoneID.ValueString()})
+			}
+		case "xelon_object_storage_user":
+			if options.ObjectStorageRegion == "" {
+				diags.AddWarning(
+					"Skipped object storage users",
+					"The Xelon API does not return the region of object storage users. Set the object storage region to export them.",
+				)
+				continue
+			}
+			configs = append(configs, map[string]string{"region": options.ObjectStorageRegion})
+		default:
+			configs = append(configs, map[string]string{})
+		}
+
+		for _, config := range configs {
+			config["tenant_id"] = tenantID
+			tflog.Debug(ctx, "Exporting objects", map[string]any{"type": typeName, "config": config})
+			resources, listDiags := exportList(ctx, client, newListResources[typeName](), newResources[typeName](), config, tenantID)
+			diags.Append(listDiags...)
+			exported = append(exported, resources...)
+		}
+	}
+
+	children, childDiags := exportChildren(ctx, client, newResources, exported)
+	diags.Append(childDiags...)
+	exported = append(exported, children...)
+
+	tenantUsers, tenantUserDiags := exportTenantUsers(ctx, client, newResources["xelon_tenant_user"](), tenantID)
+	diags.Append(tenantUserDiags...)
+	exported = append(exported, tenantUsers...)
+
+	return exported, diags
+}
+
+// configureExport configures the provider with an empty configuration in
+// read-only mode and returns the Xelon client.
+func (p *xelonProvider) configureExport(ctx context.Context, diags *diag.Diagnostics) *xelon.Client {
+	schemaResponse := &provider.SchemaResponse{}
+	p.Schema(ctx, provider.SchemaRequest{}, schemaResponse)
+	diags.Append(schemaResponse.Diagnostics...)
+
+	attributes := make(map[string]tftypes.Value, len(schemaResponse.Schema.Attributes))
+	for name, attribute := range schemaResponse.Schema.Attributes {
+		attributes[name] = tftypes.NewValue(attribute.GetType().TerraformType(ctx), nil)
+	}
+	// the export only reads objects, so guard against any change
+	attributes["read_only"] = tftypes.NewValue(tftypes.Bool, true)
+
+	configureResponse := &provider.ConfigureResponse{}
+	p.Configure(ctx, provider.ConfigureRequest{
+		Config: tfsdk.Config{
+			Schema: schemaResponse.Schema,
+			Raw:    tftypes.NewValue(schemaResponse.Schema.Type().TerraformType(ctx), attributes),
+		},
+	}, configureResponse)
+	diags.Append(configureResponse.Diagnostics...)
+	if diags.HasError() {
+		return nil
+	}
+
+	client, _ := configureResponse.ResourceData.(*xelon.Client)
+	return client
+}
+
+// exportList lists the objects of a list resource with the config values,
+// including the resources. Objects of other tenants are skipped, objects
+// without tenant in their state are kept, e.g. templates and ISOs, as the
+// Xelon API does not return their tenant.
+func exportList(ctx context.Context, client *xelon.Client, listResource list.ListResource, r resource.Resource, config map[string]string, tenantID string) ([]ExportedResource, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if listResource, ok := listResource.(list.ListResourceWithConfigure); ok {
+		configureResponse := &resource.ConfigureResponse{}
+		listResource.Configure(ctx, resource.ConfigureRequest{ProviderData: client}, configureResponse)
+		diags.Append(configureResponse.Diagnostics...)
+	}
+
+	metadataResponse := &resource.MetadataResponse{}
+	listResource.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "xelon"}, metadataResponse)
+	configSchemaResponse := &list.ListResourceSchemaResponse{}
+	listResource.ListResourceConfigSchema(ctx, list.ListResourceSchemaRequest{}, configSchemaResponse)
+	diags.Append(configSchemaResponse.Diagnostics...)
+	resourceSchema, identitySchema, schemaDiags := exportSchemas(ctx, r)
+	diags.Append(schemaDiags...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	attributes := make(map[string]tftypes.Value, len(configSchemaResponse.Schema.Attributes))
+	for name, attribute := range configSchemaResponse.Schema.Attributes {
+		if value, ok := config[name]; ok {
+			attributes[name] = tftypes.NewValue(tftypes.String, value)
+		} else {
+			attributes[name] = tftypes.NewValue(attribute.GetType().TerraformType(ctx), nil)
+		}
+	}
+
+	stream := &list.ListResultsStream{}
+	listResource.List(ctx, list.ListRequest{
+		Config: tfsdk.Config{
+			Schema: configSchemaResponse.Schema,
+			Raw:    tftypes.NewValue(configSchemaResponse.Schema.Type().TerraformType(ctx), attributes),
+		},
+		IncludeResource:        true,
+		ResourceSchema:         resourceSchema,
+		ResourceIdentitySchema: identitySchema,
+	}, stream)
+	if stream.Results == nil {
+		return nil, diags
+	}
+
+	var exported []ExportedResource
+	_, hasTenant := resourceSchema.Attributes["tenant_id"]
+	for result := range stream.Results {
+		diags.Append(result.Diagnostics...)
+		if result.Resource == nil {
+			continue
+		}
+		if hasTenant {
+			var resourceTenantID types.String
+			diags.Append(result.Resource.GetAttribute(ctx, path.Root("tenant_id"), &resourceTenantID)...)
+			if !resourceTenantID.IsNull() && resourceTenantID.ValueString() != tenantID {
+				continue
+			}
+		}
+
+		exported = append(exported, ExportedResource{
+			TypeName:    metadataResponse.TypeName,
+			DisplayName: result.DisplayName,
+			Schema:      resourceSchema,
+			Identity:    result.Identity,
+			Resource:    result.Resource,
+		})
+	}
+
+	return exported, diags
+}
+
+// exportChild is an object without list resource, identified through its
+// parent object.
+type exportChild struct {
+	typeName    string
+	displayName string
+	identity    any
+}
+
+// exportChildren reads the child objects of the exported parents listed in
+// exportChildTypeNames: the backup assignments of devices, the SOA settings of
+// DNS zones, the forwarding rules of firewalls and load balancers, and the
+// access keys of object storage users.
+func exportChildren(ctx context.Context, client *xelon.Client, newResources map[string]func() resource.Resource, parents []ExportedResource) ([]ExportedResource, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var children []exportChild
+	for _, parent := range parents {
+		var parentID types.String
+		idDiags := parent.Resource.GetAttribute(ctx, path.Root("id"), &parentID)
+		diags.Append(idDiags...)
+		if idDiags.HasError() {
+			continue
+		}
+
+		switch parent.TypeName {
+		case "xelon_device":
+			children = append(children, exportChild{
+				typeName:    "xelon_device_backup",
+				displayName: parent.DisplayName,
+				identity:    deviceBackupResourceIdentityModel{DeviceID: parentID},
+			})
+		case "xelon_dns_zone":
+			children = append(children, exportChild{
+				typeName:    "xelon_dns_soa",
+				displayName: parent.DisplayName,
+				identity:    dnsSOAResourceIdentityModel{ZoneID: parentID},
+			})
+		case "xelon_firewall":
+			tflog.Debug(ctx, "Getting firewall forwarding rules", map[string]any{"firewall_id": parentID.ValueString()})
+			firewall, _, err := client.Firewalls.Get(ctx, parentID.ValueString())
+			if err != nil {
+				diags.AddError("Unable to get firewall", err.Error())
+				continue
+			}
+			for _, forwardingRule := range firewall.ForwardingRules {
+				children = append(children, exportChild{
+					typeName:    "xelon_firewall_forwarding_rule",
+					displayName: fmt.Sprintf("%s %s %d", parent.DisplayName, forwardingRule.Protocol, forwardingRule.ExternalPort),
+					identity: firewallForwardingRuleResourceIdentityModel{
+						FirewallID: parentID,
+						ID:         types.StringValue(forwardingRule.ID),
+					},
+				})
+			}
+		case "xelon_load_balancer":
+			tflog.Debug(ctx, "Getting load balancer forwarding rules", map[string]any{"load_balancer_id": parentID.ValueString()})
+			loadBalancer, _, err := client.LoadBalancers.Get(ctx, parentID.ValueString())
+			if err != nil {
+				diags.AddError("Unable to get load balancer", err.Error())
+				continue
+			}
+			for _, forwardingRule := range loadBalancer.ForwardingRules {
+				children = append(children, exportChild{
+					typeName:    "xelon_load_balancer_forwarding_rule",
+					displayName: parent.DisplayName + " " + forwardingRule.ID,
+					identity: loadBalancerForwardingRuleResourceIdentityModel{
+						ID:             types.StringValue(forwardingRule.ID),
+						LoadBalancerID: parentID,
+					},
+				})
+			}
+		case "xelon_object_storage_user":
+			tflog.Debug(ctx, "Getting object storage access keys", map[string]any{"user_id": parentID.ValueString()})
+			user, _, err := client.ObjectStorages.GetUser(ctx, parentID.ValueString())
+			if err != nil {
+				diags.AddError("Unable to get object storage user", err.Error())
+				continue
+			}
+			for _, token := range user.Tokens {
+				children = append(children, exportChild{
+					typeName:    "xelon_object_storage_access_key",
+					displayName: parent.DisplayName + " " + token.AccessKey,
+					identity: objectStorageAccessKeyResourceIdentityModel{
+						ID:                  types.StringValue(token.ID),
+						ObjectStorageUserID: parentID,
+					},
+				})
+			}
+		}
+	}
+
+	var exported []ExportedResource
+	for _, child := range children {
+		tflog.Debug(ctx, "Exporting object", map[string]any{"type": child.typeName, "display_name": child.displayName})
+		imported, importDiags := exportImport(ctx, client, newResources[child.typeName](), child.typeName, child.displayName, child.identity)
+		diags.Append(importDiags...)
+		if imported != nil {
+			exported = append(exported, *imported)
+		}
+	}
+
+	return exported, diags
+}
+
+// exportTenantUsers reads the users of the tenant, which have no list
+// resource.
+func exportTenantUsers(ctx context.Context, client *xelon.Client, r resource.Resource, tenantID string) ([]ExportedResource, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	tflog.Debug(ctx, "Getting tenant users", map[string]any{"tenant_id": tenantID})
+	var exported []ExportedResource
+	users, errf := client.TenantUsers.All(ctx, tenantID, nil)
+	for user := range users {
+		tenantUser, importDiags := exportImport(ctx, client, r, "xelon_tenant_user", user.FirstName+" "+user.LastName, tenantUserResourceIdentityModel{
+			ID:       types.StringValue(user.ID),
+			TenantID: types.StringValue(tenantID),
+		})
+		diags.Append(importDiags...)
+		if tenantUser != nil {
+			exported = append(exported, *tenantUser)
+		}
+	}
+	if err := errf(); err != nil {
+		diags.AddError("Unable to list tenant users", err.Error())
+	}
+
+	return exported, diags
+}
+
+// exportImport reads the object with the identity like an import block. It
+// returns nil if the object does not exist, e.g. a device without backup plan.
+func exportImport(ctx context.Context, client *xelon.Client, r resource.Resource, typeName, displayName string, identityModel any) (*ExportedResource, diag.Diagnostics) {
+	resourceSchema, identitySchema, diags := exportSchemas(ctx, r)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	identity := &tfsdk.ResourceIdentity{
+		Schema: identitySchema,
+		Raw:    tftypes.NewValue(identitySchema.Type().TerraformType(ctx), nil),
+	}
+	diags.Append(identity.Set(ctx, identityModel)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+	state := tfsdk.State{
+		Schema: resourceSchema,
+		Raw:    tftypes.NewValue(resourceSchema.Type().TerraformType(ctx), nil),
+	}
+	imported, importDiags := importResource(ctx, client, r, state, identity)
+	diags.Append(importDiags...)
+	if imported == nil {
+		return nil, diags
+	}
+
+	return &ExportedResource{
+		TypeName:    typeName,
+		DisplayName: displayName,
+		Schema:      resourceSchema,
+		Identity:    identity,
+		Resource:    imported,
+	}, diags
+}
+
+// exportSchemas returns the schema and identity schema of a managed resource.
+func exportSchemas(ctx context.Context, r resource.Resource) (schema.Schema, identityschema.Schema, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	schemaResponse := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResponse)
+	diags.Append(schemaResponse.Diagnostics...)
+
+	identitySchemaResponse := &resource.IdentitySchemaResponse{}
+	if r, ok := r.(resource.ResourceWithIdentity); ok {
+		r.IdentitySchema(ctx, resource.IdentitySchemaRequest{}, identitySchemaResponse)
+		diags.Append(identitySchemaResponse.Diagnostics...)
+	}
+
+	return schemaResponse.Schema, identitySchemaResponse.IdentitySchema, diags
+}
@@ -0,0 +1,396 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/zclconf/go-cty/cty"
+)
+
+const exportFileHeader = "# Generated by xelon-export. Review the configuration before applying it.\n\n"
+
+// exportReferences maps attributes holding the ID of another Xelon object to
+// the resource type of that object, by resource type and attribute path. The
+// IDs of exported objects are written as references to their resources.
+var exportReferences = map[string]map[string]string{
+	"xelon_device": {
+		"networks.id": "xelon_network",
+		"ssh_key_id":  "xelon_ssh_key",
+		"template_id": "xelon_template",
+	},
+	"xelon_device_backup": {
+		"device_id": "xelon_device",
+	},
+	"xelon_dns_record": {
+		"zone_id": "xelon_dns_zone",
+	},
+	"xelon_dns_soa": {
+		"zone_id": "xelon_dns_zone",
+	},
+	"xelon_firewall": {
+		"external_network_id": "xelon_network",
+		"internal_network_id": "xelon_network",
+	},
+	"xelon_firewall_forwarding_rule": {
+		"firewall_id": "xelon_firewall",
+	},
+	"xelon_load_balancer": {
+		"device_ids":          "xelon_device",
+		"external_network_id": "xelon_network",
+		"network_id":          "xelon_network",
+	},
+	"xelon_load_balancer_forwarding_rule": {
+		"load_balancer_id": "xelon_load_balancer",
+	},
+	"xelon_object_storage_access_key": {
+		"user_id": "xelon_object_storage_user",
+	},
+	"xelon_object_storage_bucket": {
+		"user_id": "xelon_object_storage_user",
+	},
+	"xelon_persistent_storage": {
+		"device_id": "xelon_device",
+	},
+	"xelon_template": {
+		"device_id": "xelon_device",
+	},
+}
+
+// exportWriteOnlySecrets lists the write-only attributes set from ephemeral
+// variables, as the Xelon API does not return the secrets. Their version
+// attribute is left commented out, as setting it changes the secret on the
+// next apply, e.g. resets the password of every tenant user. The device
+// password is not listed, as setting it replaces the device.
+var exportWriteOnlySecrets = map[string][]string{
+	"xelon_tenant_user": {"password_wo"},
+}
+
+var exportInvalidNameCharacters = regexp.MustCompile(`[^a-z0-9_-]+`)
+
+// exportGenerator writes exported resources as Terraform configuration.
+type exportGenerator struct {
+	// names holds the resource name by resource type and object ID
+	names     map[string]map[string]string
+	variables *hclwrite.File
+}
+
+// GenerateConfig returns the Terraform configuration files of the exported
+// resources by file name, one for each resource type and one for the
+// variables of the secrets.
+func GenerateConfig(ctx context.Context, resources []ExportedResource) (map[string][]byte, error) {
+	g := &exportGenerator{
+		names:     make(map[string]map[string]string),
+		variables: hclwrite.NewEmptyFile(),
+	}
+
+	// name all resources first to resolve references regardless of the order
+	resourceNames := make([]string, len(resources))
+	usedNames := make(map[string]bool)
+	for i, r := range resources {
+		baseName := exportResourceName(r.TypeName, r.DisplayName)
+		name := baseName
+		for n := 2; usedNames[r.TypeName+"."+name]; n++ {
+			name = fmt.Sprintf("%s_%d", baseName, n)
+		}
+		usedNames[r.TypeName+"."+name] = true
+		resourceNames[i] = name
+
+		// objects without ID, e.g. device backups, are never referenced
+		if _, ok := r.Schema.Attributes["id"]; !ok {
+			continue
+		}
+		var id types.String
+		if diags := r.Resource.GetAttribute(ctx, path.Root("id"), &id); diags.HasError() {
+			return nil, fmt.Errorf("unable to get ID of %s %q: %s", r.TypeName, r.DisplayName, diags.Errors()[0].Detail())
+		}
+		if g.names[r.TypeName] == nil {
+			g.names[r.TypeName] = make(map[string]string)
+		}
+		g.names[r.TypeName][id.ValueString()] = name
+	}
+
+	files := make(map[string]*hclwrite.File)
+	for i, r := range resources {
+		file, ok := files[r.TypeName]
+		if !ok {
+			file = hclwrite.NewEmptyFile()
+			files[r.TypeName] = file
+		} else {
+			file.Body().AppendNewline()
+		}
+
+		if err := g.writeResource(file.Body(), r, resourceNames[i]); err != nil {
+			return nil, fmt.Errorf("unable to write %s %q: %w", r.TypeName, r.DisplayName, err)
+		}
+	}
+
+	output := make(map[string][]byte, len(files)+1)
+	for typeName, file := range files {
+		output[typeName+".tf"] = append([]byte(exportFileHeader), hclwrite.Format(file.Bytes())...)
+	}
+	if len(g.variables.Body().Blocks()) > 0 {
+		output["variables.tf"] = append([]byte(exportFileHeader), hclwrite.Format(g.variables.Bytes())...)
+	}
+
+	return output, nil
+}
+
+// exportResourceName returns a valid resource name for the display name of an
+// object, e.g. web_01 for "Web 01".
+func exportResourceName(typeName, displayName string) string {
+	name := strings.Trim(exportInvalidNameCharacters.ReplaceAllString(strings.ToLower(displayName), "_"), "_-")
+	if name == "" || name[0] < 'a' || name[0] > 'z' {
+		name = strings.Trim(strings.TrimPrefix(typeName, "xelon_")+"_"+name, "_")
+	}
+	return name
+}
+
+// writeResource writes the import block and the resource block of an
+// exported resource.
+func (g *exportGenerator) writeResource(body *hclwrite.Body, r ExportedResource, name string) error {
+	var identity map[string]tftypes.Value
+	if err := r.Identity.Raw.As(&identity); err != nil {
+		return err
+	}
+	identityTokens := make([]hclwrite.ObjectAttrTokens, 0, len(identity))
+	for _, attributeName := range sortedKeys(identity) {
+		valueTokens, err := g.valueTokens("", identity[attributeName])
+		if err != nil {
+			return err
+		}
+		identityTokens = append(identityTokens, hclwrite.ObjectAttrTokens{
+			Name:  hclwrite.TokensForIdentifier(attributeName),
+			Value: valueTokens,
+		})
+	}
+
+	importBody := body.AppendNewBlock("import", nil).Body()
+	importBody.SetAttributeTraversal("to", hcl.Traversal{
+		hcl.TraverseRoot{Name: r.TypeName},
+		hcl.TraverseAttr{Name: name},
+	})
+	importBody.SetAttributeRaw("identity", hclwrite.TokensForObject(identityTokens))
+	body.AppendNewline()
+
+	var values map[string]tftypes.Value
+	if err := r.Resource.Raw.As(&values); err != nil {
+		return err
+	}
+
+	resourceBody := body.AppendNewBlock("resource", []string{r.TypeName, name}).Body()
+	for _, attributeName := range sortedKeys(r.Schema.Attributes) {
+		attribute := r.Schema.Attributes[attributeName]
+		value := values[attributeName]
+		variableName := strings.TrimPrefix(r.TypeName, "xelon_") + "_" + name + "_" + strings.TrimSuffix(attributeName, "_wo")
+
+		switch {
+		case !attribute.IsRequired() && !attribute.IsOptional():
+			continue
+		case slices.Contains(exportWriteOnlySecrets[r.TypeName], attributeName):
+			resourceBody.SetAttributeTraversal(attributeName, variableTraversal(variableName))
+			resourceBody.AppendUnstructuredTokens(hclwrite.Tokens{{
+				Type: hclsyntax.TokenComment,
+				Bytes: []byte(fmt.Sprintf("# Uncomment to set %[1]s on the next apply, which overwrites the current value.\n"+
+					"# %[1]s_version = 1\n", attributeName)),
+			}})
+			g.addVariable(variableName, true)
+		case attribute.IsRequired() && value.IsNull() && attribute.GetType().Equal(types.StringType):
+			// e.g. the template of a device, which is not returned by the Xelon API
+			resourceBody.SetAttributeTraversal(attributeName, variableTraversal(variableName))
+			g.addInputVariable(variableName, fmt.Sprintf("The %s of %s, which is not returned by the Xelon API.", attributeName, r.DisplayName))
+		case attribute.IsWriteOnly() || value.IsNull() || !value.IsKnown():
+			continue
+		case attribute.IsSensitive():
+			resourceBody.SetAttributeTraversal(attributeName, variableTraversal(variableName))
+			g.addVariable(variableName, false)
+		default:
+			tokens, err := g.attributeTokens(r.TypeName, attributeName, attribute, value)
+			if err != nil {
+				return err
+			}
+			resourceBody.SetAttributeRaw(attributeName, tokens)
+		}
+	}
+
+	return nil
+}
+
+// addVariable adds a sensitive string variable for a secret.
+func (g *exportGenerator) addVariable(name string, ephemeral bool) {
+	body := g.variables.Body()
+	if len(body.Blocks()) > 0 {
+		body.AppendNewline()
+	}
+
+	variableBody := body.AppendNewBlock("variable", []string{name}).Body()
+	variableBody.SetAttributeTraversal("type", hcl.Traversal{hcl.TraverseRoot{Name: "string"}})
+	variableBody.SetAttributeValue("sensitive", cty.True)
+	if ephemeral {
+		variableBody.SetAttributeValue("ephemeral", cty.True)
+	}
+}
+
+// addInputVariable adds a string variable for a required value the Xelon API
+// does not return.
+func (g *exportGenerator) addInputVariable(name, description string) {
+	body := g.variables.Body()
+	if len(body.Blocks()) > 0 {
+		body.AppendNewline()
+	}
+
+	variableBody := body.AppendNewBlock("variable", []string{name}).Body()
+	variableBody.SetAttributeTraversal("type", hcl.Traversal{hcl.TraverseRoot{Name: "string"}})
+	variableBody.SetAttributeValue("description", cty.StringVal(description))
+}
+
+// attributeTokens returns the expression of an attribute value, writing only
+// the configurable attributes of nested attributes.
+func (g *exportGenerator) attributeTokens(typeName, attributePath string, attribute schema.Attribute, value tftypes.Value) (hclwrite.Tokens, error) {
+	switch attribute := attribute.(type) {
+	case schema.SingleNestedAttribute:
+		return g.objectTokens(typeName, attributePath, attribute.Attributes, value)
+	case schema.ListNestedAttribute:
+		return g.nestedObjectsTokens(typeName, attributePath, attribute.NestedObject.Attributes, value)
+	case schema.SetNestedAttribute:
+		return g.nestedObjectsTokens(typeName, attributePath, attribute.NestedObject.Attributes, value)
+	default:
+		return g.valueTokens(exportReferences[typeName][attributePath], value)
+	}
+}
+
+func (g *exportGenerator) nestedObjectsTokens(typeName, attributePath string, attributes map[string]schema.Attribute, value tftypes.Value) (hclwrite.Tokens, error) {
+	var elements []tftypes.Value
+	if err := value.As(&elements); err != nil {
+		return nil, err
+	}
+
+	elementsTokens := make([]hclwrite.Tokens, 0, len(elements))
+	for _, element := range elements {
+		tokens, err := g.objectTokens(typeName, attributePath, attributes, element)
+		if err != nil {
+			return nil, err
+		}
+		elementsTokens = append(elementsTokens, tokens)
+	}
+	return hclwrite.TokensForTuple(elementsTokens), nil
+}
+
+func (g *exportGenerator) objectTokens(typeName, attributePath string, attributes map[string]schema.Attribute, value tftypes.Value) (hclwrite.Tokens, error) {
+	var values map[string]tftypes.Value
+	if err := value.As(&values); err != nil {
+		return nil, err
+	}
+
+	attributesTokens := make([]hclwrite.ObjectAttrTokens, 0, len(values))
+	for _, attributeName := range sortedKeys(attributes) {
+		attribute := attributes[attributeName]
+		value := values[attributeName]
+		if !attribute.IsRequired() && !attribute.IsOptional() || attribute.IsWriteOnly() || value.IsNull() || !value.IsKnown() {
+			continue
+		}
+
+		tokens, err := g.attributeTokens(typeName, attributePath+"."+attributeName, attribute, value)
+		if err != nil {
+			return nil, err
+		}
+		attributesTokens = append(attributesTokens, hclwrite.ObjectAttrTokens{
+			Name:  hclwrite.TokensForIdentifier(attributeName),
+			Value: tokens,
+		})
+	}
+	return hclwrite.TokensForObject(attributesTokens), nil
+}
+
+// valueTokens returns the expression of a value. A string holding the ID of
+// an exported object of the referenced type is written as a reference.
+func (g *exportGenerator) valueTokens(referencedType string, value tftypes.Value) (hclwrite.Tokens, error) {
+	switch {
+	case value.IsNull():
+		return hclwrite.TokensForValue(cty.NullVal(cty.DynamicPseudoType)), nil
+	case value.Type().Is(tftypes.String):
+		var s string
+		if err := value.As(&s); err != nil {
+			return nil, err
+		}
+		if name, ok := g.names[referencedType][s]; ok {
+			return hclwrite.TokensForTraversal(hcl.Traversal{
+				hcl.TraverseRoot{Name: referencedType},
+				hcl.TraverseAttr{Name: name},
+				hcl.TraverseAttr{Name: "id"},
+			}), nil
+		}
+		return hclwrite.TokensForValue(cty.StringVal(s)), nil
+	case value.Type().Is(tftypes.Number):
+		n := new(big.Float)
+		if err := value.As(&n); err != nil {
+			return nil, err
+		}
+		return hclwrite.TokensForValue(cty.NumberVal(n)), nil
+	case value.Type().Is(tftypes.Bool):
+		var b bool
+		if err := value.As(&b); err != nil {
+			return nil, err
+		}
+		return hclwrite.TokensForValue(cty.BoolVal(b)), nil
+	case value.Type().Is(tftypes.List{}), value.Type().Is(tftypes.Set{}), value.Type().Is(tftypes.Tuple{}):
+		var elements []tftypes.Value
+		if err := value.As(&elements); err != nil {
+			return nil, err
+		}
+		elementsTokens := make([]hclwrite.Tokens, 0, len(elements))
+		for _, element := range elements {
+			tokens, err := g.valueTokens(referencedType, element)
+			if err != nil {
+				return nil, err
+			}
+			elementsTokens = append(elementsTokens, tokens)
+		}
+		return hclwrite.TokensForTuple(elementsTokens), nil
+	case value.Type().Is(tftypes.Map{}), value.Type().Is(tftypes.Object{}):
+		var elements map[string]tftypes.Value
+		if err := value.As(&elements); err != nil {
+			return nil, err
+		}
+		elementsTokens := make([]hclwrite.ObjectAttrTokens, 0, len(elements))
+		for _, key := range sortedKeys(elements) {
+			tokens, err := g.valueTokens(referencedType, elements[key])
+			if err != nil {
+				return nil, err
+			}
+			elementsTokens = append(elementsTokens, hclwrite.ObjectAttrTokens{
+				Name:  hclwrite.TokensForValue(cty.StringVal(key)),
+				Value: tokens,
+			})
+		}
+		return hclwrite.TokensForObject(elementsTokens), nil
+	default:
+		return nil, fmt.Errorf("unsupported value type %s", value.Type())
+	}
+}
+
+func variableTraversal(name string) hcl.Traversal {
+	return hcl.Traversal{
+		hcl.TraverseRoot{Name: "var"},
+		hcl.TraverseAttr{Name: name},
+	}
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
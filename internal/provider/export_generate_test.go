@@ -0,0 +1,286 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testExportIdentityModel struct {
+	ID types.String `tfsdk:"id"`
+}
+
+type testExportNetworkModel struct {
+	ID       types.String `tfsdk:"id"`
+	Name     types.String `tfsdk:"name"`
+	TenantID types.String `tfsdk:"tenant_id"`
+}
+
+type testExportDeviceModel struct {
+	DisplayName types.String                   `tfsdk:"display_name"`
+	ID          types.String                   `tfsdk:"id"`
+	Networks    []testExportDeviceNetworkModel `tfsdk:"networks"`
+	Password    types.String                   `tfsdk:"password"`
+	SSHKeyID    types.String                   `tfsdk:"ssh_key_id"`
+	TemplateID  types.String                   `tfsdk:"template_id"`
+}
+
+type testExportDeviceNetworkModel struct {
+	ID          types.String `tfsdk:"id"`
+	IPv4Address types.String `tfsdk:"ipv4_address"`
+}
+
+type testExportTenantUserModel struct {
+	Email             types.String `tfsdk:"email"`
+	ID                types.String `tfsdk:"id"`
+	Password          types.String `tfsdk:"password"`
+	PasswordWO        types.String `tfsdk:"password_wo"`
+	PasswordWOVersion types.Int64  `tfsdk:"password_wo_version"`
+}
+
+func TestGenerateConfig(t *testing.T) {
+	ctx := context.Background()
+
+	network := testExportedResource(t, "xelon_network", "Backend", schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":        schema.StringAttribute{Computed: true},
+			"name":      schema.StringAttribute{Required: true},
+			"tenant_id": schema.StringAttribute{Optional: true, Computed: true},
+		},
+	}, &testExportNetworkModel{
+		ID:       types.StringValue("network-1"),
+		Name:     types.StringValue("Backend"),
+		TenantID: types.StringValue("tenant-1"),
+	})
+	device := testExportedResource(t, "xelon_device", "Web 01", schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"display_name": schema.StringAttribute{Required: true},
+			"id":           schema.StringAttribute{Computed: true},
+			"networks": schema.SetNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id":           schema.StringAttribute{Required: true},
+						"ipv4_address": schema.StringAttribute{Computed: true},
+					},
+				},
+				Required: true,
+			},
+			"password":    schema.StringAttribute{Optional: true, Sensitive: true},
+			"ssh_key_id":  schema.StringAttribute{Optional: true},
+			"template_id": schema.StringAttribute{Required: true},
+		},
+	}, &testExportDeviceModel{
+		DisplayName: types.StringValue("Web 01"),
+		ID:          types.StringValue("device-1"),
+		Networks: []testExportDeviceNetworkModel{
+			{ID: types.StringValue("network-1"), IPv4Address: types.StringValue("10.0.0.10")},
+		},
+		Password:   types.StringValue("secret"),
+		SSHKeyID:   types.StringValue("ssh-key-1"),
+		TemplateID: types.StringNull(),
+	})
+	tenantUser := testExportedResource(t, "xelon_tenant_user", "John Doe", schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"email":               schema.StringAttribute{Required: true},
+			"id":                  schema.StringAttribute{Computed: true},
+			"password":            schema.StringAttribute{Optional: true, Sensitive: true},
+			"password_wo":         schema.StringAttribute{Optional: true, Sensitive: true, WriteOnly: true},
+			"password_wo_version": schema.Int64Attribute{Optional: true},
+		},
+	}, &testExportTenantUserModel{
+		Email:             types.StringValue("john.doe@example.com"),
+		ID:                types.StringValue("user-1"),
+		Password:          types.StringNull(),
+		PasswordWO:        types.StringNull(),
+		PasswordWOVersion: types.Int64Null(),
+	})
+
+	files, err := GenerateConfig(ctx, []ExportedResource{device, network, tenantUser})
+
+	require.NoError(t, err)
+	assert.Equal(t, exportFileHeader+`import {
+  to = xelon_network.backend
+  identity = {
+    id = "network-1"
+  }
+}
+
+resource "xelon_network" "backend" {
+  name      = "Backend"
+  tenant_id = "tenant-1"
+}
+`, string(files["xelon_network.tf"]))
+	assert.Equal(t, exportFileHeader+`import {
+  to = xelon_device.web_01
+  identity = {
+    id = "device-1"
+  }
+}
+
+resource "xelon_device" "web_01" {
+  display_name = "Web 01"
+  networks = [{
+    id = xelon_network.backend.id
+  }]
+  password    = var.device_web_01_password
+  ssh_key_id  = "ssh-key-1"
+  template_id = var.device_web_01_template_id
+}
+`, string(files["xelon_device.tf"]))
+	assert.Equal(t, exportFileHeader+`import {
+  to = xelon_tenant_user.john_doe
+  identity = {
+    id = "user-1"
+  }
+}
+
+resource "xelon_tenant_user" "john_doe" {
+  email       = "john.doe@example.com"
+  password_wo = var.tenant_user_john_doe_password
+  # Uncomment to set password_wo on the next apply, which overwrites the current value.
+  # password_wo_version = 1
+}
+`, string(files["xelon_tenant_user.tf"]))
+	assert.Equal(t, exportFileHeader+`variable "device_web_01_password" {
+  type      = string
+  sensitive = true
+}
+
+variable "device_web_01_template_id" {
+  type        = string
+  description = "The template_id of Web 01, which is not returned by the Xelon API."
+}
+
+variable "tenant_user_john_doe_password" {
+  type      = string
+  sensitive = true
+  ephemeral = true
+}
+`, string(files["variables.tf"]))
+}
+
+func TestGenerateConfig_UniqueNames(t *testing.T) {
+	ctx := context.Background()
+	resourceSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":   schema.StringAttribute{Computed: true},
+			"name": schema.StringAttribute{Required: true},
+		},
+	}
+
+	files, err := GenerateConfig(ctx, []ExportedResource{
+		testExportedResource(t, "xelon_network", "LAN", resourceSchema, &struct {
+			ID   types.String `tfsdk:"id"`
+			Name types.String `tfsdk:"name"`
+		}{ID: types.StringValue("network-1"), Name: types.StringValue("LAN")}),
+		testExportedResource(t, "xelon_network", "lan", resourceSchema, &struct {
+			ID   types.String `tfsdk:"id"`
+			Name types.String `tfsdk:"name"`
+		}{ID: types.StringValue("network-2"), Name: types.StringValue("lan")}),
+	})
+
+	require.NoError(t, err)
+	assert.Contains(t, string(files["xelon_network.tf"]), `resource "xelon_network" "lan" {`)
+	assert.Contains(t, string(files["xelon_network.tf"]), `resource "xelon_network" "lan_2" {`)
+	assert.NotContains(t, files, "variables.tf")
+}
+
+func TestExportReferences_MatchProviderSchemas(t *testing.T) {
+	ctx := context.Background()
+
+	schemas := make(map[string]schema.Schema)
+	for _, newResource := range New("test")().Resources(ctx) {
+		r := newResource()
+		metadataResponse := &resource.MetadataResponse{}
+		r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "xelon"}, metadataResponse)
+		schemaResponse := &resource.SchemaResponse{}
+		r.Schema(ctx, resource.SchemaRequest{}, schemaResponse)
+		schemas[metadataResponse.TypeName] = schemaResponse.Schema
+	}
+
+	for typeName, attributeReferences := range exportReferences {
+		for attributePath, referencedType := range attributeReferences {
+			assert.Contains(t, schemas, referencedType)
+
+			attributes := schemas[typeName].Attributes
+			var attribute schema.Attribute
+			for _, name := range strings.Split(attributePath, ".") {
+				var ok bool
+				attribute, ok = attributes[name]
+				require.True(t, ok, "%s has no attribute %s", typeName, attributePath)
+				if nested, ok := attribute.(schema.SetNestedAttribute); ok {
+					attributes = nested.NestedObject.Attributes
+				}
+			}
+			assert.True(t, attribute.IsRequired() || attribute.IsOptional(), "%s.%s must be configurable", typeName, attributePath)
+		}
+	}
+	for typeName, attributeNames := range exportWriteOnlySecrets {
+		for _, attributeName := range attributeNames {
+			assert.True(t, schemas[typeName].Attributes[attributeName].IsWriteOnly(), "%s.%s must be write-only", typeName, attributeName)
+			assert.Contains(t, schemas[typeName].Attributes, attributeName+"_version")
+		}
+	}
+}
+
+func TestExportResourceName(t *testing.T) {
+	tests := map[string]struct {
+		displayName string
+		expected    string
+	}{
+		"lowercase":          {displayName: "web", expected: "web"},
+		"invalid characters": {displayName: "Web Server (01)", expected: "web_server_01"},
+		"dashes":             {displayName: "web-01", expected: "web-01"},
+		"leading digit":      {displayName: "01-web", expected: "device_01-web"},
+		"domain":             {displayName: "example.com", expected: "example_com"},
+		"empty":              {displayName: "", expected: "device"},
+		"only invalid":       {displayName: "***", expected: "device"},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.expected, exportResourceName("xelon_device", test.displayName))
+		})
+	}
+}
+
+func testExportedResource(t *testing.T, typeName, displayName string, resourceSchema schema.Schema, state any) ExportedResource {
+	t.Helper()
+	ctx := context.Background()
+
+	identitySchema := identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{RequiredForImport: true},
+		},
+	}
+	exported := ExportedResource{
+		TypeName:    typeName,
+		DisplayName: displayName,
+		Schema:      resourceSchema,
+		Identity: &tfsdk.ResourceIdentity{
+			Schema: identitySchema,
+			Raw:    tftypes.NewValue(identitySchema.Type().TerraformType(ctx), nil),
+		},
+		Resource: &tfsdk.Resource{
+			Schema: resourceSchema,
+			Raw:    tftypes.NewValue(resourceSchema.Type().TerraformType(ctx), nil),
+		},
+	}
+	require.False(t, exported.Resource.Set(ctx, state).HasError())
+
+	var id types.String
+	require.False(t, exported.Resource.GetAttribute(ctx, path.Root("id"), &id).HasError())
+	require.False(t, exported.Identity.Set(ctx, testExportIdentityModel{ID: id}).HasError())
+
+	return exported
+}
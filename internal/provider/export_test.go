@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Xelon-AG/xelon-sdk-go/xelon"
+)
+
+func TestExport_TypeNames(t *testing.T) {
+	ctx := context.Background()
+	p := New("test")().(*xelonProvider)
+
+	var listTypeNames []string
+	for _, newListResource := range p.ListResources(ctx) {
+		metadataResponse := &resource.MetadataResponse{}
+		newListResource().Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "xelon"}, metadataResponse)
+		listTypeNames = append(listTypeNames, metadataResponse.TypeName)
+	}
+
+	// every list resource is exported exactly once
+	assert.ElementsMatch(t, listTypeNames, exportTypeNames)
+	for childTypeName, parentTypeName := range exportChildTypeNames {
+		assert.Contains(t, exportTypeNames, parentTypeName, childTypeName)
+	}
+
+	// every other resource is exported through its parent or excluded
+	for _, newResource := range p.Resources(ctx) {
+		metadataResponse := &resource.MetadataResponse{}
+		newResource().Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "xelon"}, metadataResponse)
+		typeName := metadataResponse.TypeName
+		_, isChild := exportChildTypeNames[typeName]
+		_, isExcluded := exportExcludedTypeNames[typeName]
+		assert.True(t, slices.Contains(exportTypeNames, typeName) || isChild || isExcluded || typeName == "xelon_tenant_user",
+			"%s is neither exported nor excluded", typeName)
+	}
+	assert.Less(t,
+		slices.Index(exportTypeNames, "xelon_dns_zone"), slices.Index(exportTypeNames, "xelon_dns_record"),
+		"DNS zones must be exported before their records",
+	)
+}
+
+func TestExport_FakeServer(t *testing.T) {
+	server := newFakeXelonServer(t)
+	server.Mutate(func(s *fakeXelonServer) {
+		s.networks["network-1"] = &xelon.Network{
+			Clouds:     []xelon.Cloud{{ID: "1"}},
+			ID:         "network-1",
+			Name:       "Backend",
+			Network:    "10.0.0.0",
+			Owner:      &xelon.Tenant{ID: "tenant-123"},
+			SubnetSize: 24,
+			Type:       "LAN",
+		}
+		s.devices["device-1"] = &xelon.Device{
+			CPUCores:    2,
+			DisplayName: "Web 01",
+			HostName:    "web-01",
+			ID:          "device-1",
+			PoweredOn:   true,
+			RAM:         4,
+			State:       1,
+			Storages:    []xelon.DeviceStorage{{ID: "disk-1", Size: 20}},
+			Tenant:      &xelon.Tenant{ID: "tenant-123"},
+		}
+		s.deviceNetworks["device-1"] = []xelon.DeviceNetwork{{Connected: true, ID: "network-1"}}
+		s.deviceBackupPlans["device-1"] = 1
+	})
+	t.Setenv("XELON_BASE_URL", server.BaseURL())
+	t.Setenv("XELON_CLIENT_ID", "fake-client-id")
+	t.Setenv("XELON_PROFILE", "")
+	t.Setenv("XELON_TENANT_ID", "")
+	t.Setenv("XELON_TOKEN", "fake-token")
+
+	ctx := context.Background()
+	resources, diags := Export(ctx, "test", ExportOptions{})
+	require.False(t, diags.HasError(), "%v", diags)
+
+	files, err := GenerateConfig(ctx, resources)
+
+	require.NoError(t, err)
+	assert.Contains(t, string(files["xelon_network.tf"]), `resource "xelon_network" "backend" {`)
+	assert.Contains(t, string(files["xelon_device.tf"]), `resource "xelon_device" "web_01" {`)
+	assert.Contains(t, string(files["xelon_device.tf"]), `id = xelon_network.backend.id`)
+	assert.Contains(t, string(files["xelon_device.tf"]), `= var.device_web_01_template_id`)
+	assert.Contains(t, string(files["variables.tf"]), `variable "device_web_01_template_id" {`)
+	assert.Contains(t, string(files["xelon_device_backup.tf"]), `device_id      = xelon_device.web_01.id`)
+	assert.NotContains(t, files, "xelon_kubernetes_cluster.tf")
+}
@@ -0,0 +1,209 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/Xelon-AG/terraform-provider-xelon/internal/provider/helper"
+	"github.com/Xelon-AG/xelon-sdk-go/xelon"
+)
+
+var _ function.Function = (*parseDNSZoneFileFunction)(nil)
+
+// parseDNSZoneFileFunction is the parse_dns_zone_file function implementation.
+type parseDNSZoneFileFunction struct{}
+
+// dnsZoneFileRecordModel maps a record returned by parse_dns_zone_file.
+type dnsZoneFileRecordModel struct {
+	Content  types.String `tfsdk:"content"`
+	Flags    types.Int64  `tfsdk:"flags"`
+	Key      types.String `tfsdk:"key"`
+	Name     types.String `tfsdk:"name"`
+	Port     types.Int64  `tfsdk:"port"`
+	Priority types.Int64  `tfsdk:"priority"`
+	Tag      types.String `tfsdk:"tag"`
+	TTL      types.Int64  `tfsdk:"ttl"`
+	Type     types.String `tfsdk:"type"`
+	Weight   types.Int64  `tfsdk:"weight"`
+}
+
+func NewParseDNSZoneFileFunction() function.Function {
+	return &parseDNSZoneFileFunction{}
+}
+
+func (f *parseDNSZoneFileFunction) Metadata(_ context.Context, _ function.MetadataRequest, response *function.MetadataResponse) {
+	response.Name = "parse_dns_zone_file"
+}
+
+func (f *parseDNSZoneFileFunction) Definition(_ context.Context, _ function.DefinitionRequest, response *function.DefinitionResponse) {
+	response.Definition = function.Definition{
+		Summary: "Parse a DNS zone file into records",
+		MarkdownDescription: `
+Parses a DNS zone file in RFC 1035 format into a list of records with the same attributes as the ` + "`xelon_dns_record`" + ` resource.
+Each record has a unique ` + "`key`" + `, so the result can be used with ` + "`for_each`" + `. The key is built from the name, type, and a
+hash of the value of the record, e.g. ` + "`@/MX/355360f6`" + `, so it does not change when other records are added or removed.
+Identical records in the zone file result in an error.
+
+The SOA record and the NS records of the zone apex are skipped, as they are managed by Xelon for every DNS zone.
+Records of types not supported by ` + "`xelon_dns_record`" + ` result in an error.
+
+Provider-defined functions require Terraform 1.8 or later.
+`,
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "content",
+				MarkdownDescription: "The zone file content, e.g. read with the `file` function.",
+			},
+			function.StringParameter{
+				Name:                "zone_name",
+				MarkdownDescription: "The name of the DNS zone, used as initial `$ORIGIN`. Record names are returned relative to this zone.",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.ObjectType{AttrTypes: dnsZoneFileRecordAttributeTypes()},
+		},
+	}
+}
+
+func (f *parseDNSZoneFileFunction) Run(ctx context.Context, request function.RunRequest, response *function.RunResponse) {
+	var content, zoneName string
+
+	response.Error = request.Arguments.Get(ctx, &content, &zoneName)
+	if response.Error != nil {
+		return
+	}
+
+	zoneFileRecords, err := helper.ParseZoneFile(content, zoneName)
+	if err != nil {
+		response.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Unable to parse zone file: %s.", err))
+		return
+	}
+
+	records, err := dnsZoneFileRecordsFromZoneFile(zoneFileRecords)
+	if err != nil {
+		response.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Unable to parse zone file: %s.", err))
+		return
+	}
+
+	result, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: dnsZoneFileRecordAttributeTypes()}, records)
+	response.Error = function.FuncErrorFromDiags(ctx, diags)
+	if response.Error != nil {
+		return
+	}
+
+	response.Error = response.Result.Set(ctx, result)
+}
+
+func dnsZoneFileRecordAttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"content":  types.StringType,
+		"flags":    types.Int64Type,
+		"key":      types.StringType,
+		"name":     types.StringType,
+		"port":     types.Int64Type,
+		"priority": types.Int64Type,
+		"tag":      types.StringType,
+		"ttl":      types.Int64Type,
+		"type":     types.StringType,
+		"weight":   types.Int64Type,
+	}
+}
+
+// dnsZoneFileRecordsFromZoneFile converts parsed zone file records into
+// xelon_dns_record compatible records. The key of a record is built from its
+// name, type, and a hash of its value, so it does not change when other
+// records are added or removed.
+func dnsZoneFileRecordsFromZoneFile(zoneFileRecords []helper.ZoneFileRecord) ([]dnsZoneFileRecordModel, error) {
+	records := make([]dnsZoneFileRecordModel, 0, len(zoneFileRecords))
+	keyLines := make(map[string]int)
+	for _, zoneFileRecord := range zoneFileRecords {
+		// SOA and apex NS records are owned by the DNS zone itself
+		if zoneFileRecord.Type == "SOA" || (zoneFileRecord.Type == string(xelon.DNSRecordTypeNS) && zoneFileRecord.Name == "@") {
+			continue
+		}
+		if !slices.Contains(supportedV0DNSRecordTypes(), zoneFileRecord.Type) {
+			return nil, fmt.Errorf("record type %s on line %d is not supported", zoneFileRecord.Type, zoneFileRecord.Line)
+		}
+
+		content, err := dnsRecordContentFromZoneFile(zoneFileRecord)
+		if err != nil {
+			return nil, err
+		}
+
+		key := buildDNSZoneFileRecordKey(zoneFileRecord.Name, zoneFileRecord.Type, content)
+		if line, ok := keyLines[key]; ok {
+			return nil, fmt.Errorf("%s record on line %d duplicates the record on line %d", zoneFileRecord.Type, zoneFileRecord.Line, line)
+		}
+		keyLines[key] = zoneFileRecord.Line
+
+		record := dnsZoneFileRecordModel{
+			Content:  types.StringValue(content.Content),
+			Flags:    types.Int64Null(),
+			Key:      types.StringValue(key),
+			Name:     types.StringValue(zoneFileRecord.Name),
+			Port:     types.Int64Null(),
+			Priority: types.Int64Null(),
+			Tag:      types.StringNull(),
+			TTL:      types.Int64Value(zoneFileRecord.TTL),
+			Type:     types.StringValue(zoneFileRecord.Type),
+			Weight:   types.Int64Null(),
+		}
+
+		switch xelon.DNSRecordType(zoneFileRecord.Type) {
+		case xelon.DNSRecordTypeCAA:
+			record.Flags = types.Int64Value(content.Flags)
+			record.Tag = types.StringValue(content.Tag)
+		case xelon.DNSRecordTypeMX:
+			record.Priority = types.Int64Value(content.Priority)
+		case xelon.DNSRecordTypeSRV:
+			record.Port = types.Int64Value(content.Port)
+			record.Priority = types.Int64Value(content.Priority)
+			record.Weight = types.Int64Value(content.Weight)
+		}
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+// buildDNSZoneFileRecordKey returns the key of a record, e.g.
+// "@/MX/355360f6" with the first 8 hex digits of the SHA-256 hash of the
+// record value.
+func buildDNSZoneFileRecordKey(name, recordType string, content dnsRecordContent) string {
+	hash := sha256.Sum256([]byte(buildDNSRecordContent(recordType, content)))
+	return fmt.Sprintf("%s/%s/%s", name, recordType, hex.EncodeToString(hash[:4]))
+}
+
+func dnsRecordContentFromZoneFile(record helper.ZoneFileRecord) (dnsRecordContent, error) {
+	var value string
+	switch xelon.DNSRecordType(record.Type) {
+	case xelon.DNSRecordTypeCAA:
+		if len(record.Data) == 3 {
+			value = fmt.Sprintf(`%s %s "%s"`, record.Data[0], record.Data[1], dnsCharacterStringEscaper.Replace(record.Data[2]))
+		}
+	case xelon.DNSRecordTypeMX, xelon.DNSRecordTypeSRV:
+		value = strings.Join(record.Data, " ")
+	case xelon.DNSRecordTypeTXT:
+		// multiple character strings form a single value
+		return dnsRecordContent{Content: strings.Join(record.Data, "")}, nil
+	default:
+		if len(record.Data) == 1 {
+			return dnsRecordContent{Content: record.Data[0]}, nil
+		}
+	}
+
+	content, ok := parseDNSRecordContent(record.Type, value)
+	if !ok || value == "" {
+		return dnsRecordContent{}, fmt.Errorf("invalid %s record data on line %d", record.Type, record.Line)
+	}
+
+	return content, nil
+}
@@ -0,0 +1,148 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Xelon-AG/terraform-provider-xelon/internal/provider/helper"
+)
+
+func TestParseDNSZoneFileFunction_Run(t *testing.T) {
+	content := `
+$TTL 3600
+@         IN SOA ns1.xdns.cloud. hostmaster.example.com. 1 7200 1800 1209600 3600
+@         IN NS  ns1.xdns.cloud.
+@         IN A   203.0.113.10
+www   300 IN CNAME @
+@         IN MX  10 mail
+@         IN MX  20 backup.mail.example.net.
+_sip._tcp IN SRV 10 5 5060 sip
+@         IN CAA 0 issue "letsencrypt.org"
+@         IN TXT "v=spf1 " "mx -all"
+sub       IN NS  ns1.example.net.
+`
+	ctx := context.Background()
+	request := function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(content), types.StringValue("example.com")}),
+	}
+	response := function.RunResponse{
+		Result: function.NewResultData(types.ListUnknown(types.ObjectType{AttrTypes: dnsZoneFileRecordAttributeTypes()})),
+	}
+
+	NewParseDNSZoneFileFunction().Run(ctx, request, &response)
+
+	require.Nil(t, response.Error)
+	result, ok := response.Result.Value().(types.List)
+	require.True(t, ok)
+	var records []dnsZoneFileRecordModel
+	require.False(t, result.ElementsAs(ctx, &records, false).HasError())
+
+	keys := make([]string, 0, len(records))
+	for _, record := range records {
+		keys = append(keys, record.Key.ValueString())
+	}
+	assert.Equal(t, []string{
+		"@/A/631f0814",
+		"www/CNAME/a379a6f6",
+		"@/MX/355360f6",
+		"@/MX/59dd1d65",
+		"_sip._tcp/SRV/955fdea7",
+		"@/CAA/a5a86de8",
+		"@/TXT/667c5001",
+		"sub/NS/39aa266b",
+	}, keys)
+
+	assert.Equal(t, "example.com", records[1].Content.ValueString())
+	assert.Equal(t, int64(300), records[1].TTL.ValueInt64())
+	assert.Equal(t, "mail.example.com", records[2].Content.ValueString())
+	assert.Equal(t, int64(10), records[2].Priority.ValueInt64())
+	assert.True(t, records[2].Port.IsNull())
+	assert.Equal(t, "sip.example.com", records[4].Content.ValueString())
+	assert.Equal(t, int64(5060), records[4].Port.ValueInt64())
+	assert.Equal(t, int64(5), records[4].Weight.ValueInt64())
+	assert.Equal(t, "letsencrypt.org", records[5].Content.ValueString())
+	assert.Equal(t, "issue", records[5].Tag.ValueString())
+	assert.Equal(t, "v=spf1 mx -all", records[6].Content.ValueString())
+}
+
+func TestDNSZoneFileRecordsFromZoneFile_KeyIndependentOfOtherRecords(t *testing.T) {
+	mx := helper.ZoneFileRecord{Data: []string{"20", "backup.mail.example.net"}, Line: 2, Name: "@", TTL: 3600, Type: "MX"}
+
+	records, err := dnsZoneFileRecordsFromZoneFile([]helper.ZoneFileRecord{mx})
+	require.NoError(t, err)
+	recordsWithOtherMX, err := dnsZoneFileRecordsFromZoneFile([]helper.ZoneFileRecord{
+		{Data: []string{"10", "mail.example.com"}, Line: 1, Name: "@", TTL: 3600, Type: "MX"},
+		mx,
+	})
+	require.NoError(t, err)
+
+	require.Len(t, records, 1)
+	require.Len(t, recordsWithOtherMX, 2)
+	assert.Equal(t, records[0].Key, recordsWithOtherMX[1].Key)
+}
+
+func TestParseDNSZoneFileFunction_RunInvalid(t *testing.T) {
+	testCases := map[string]struct {
+		content string
+		wantErr string
+	}{
+		"syntax error": {
+			content: "www A 203.0.113.10",
+			wantErr: "Unable to parse zone file: missing TTL on line 1.",
+		},
+		"unsupported type": {
+			content: "@ 3600 IN DS 12345 13 2 abcdef",
+			wantErr: "Unable to parse zone file: record type DS on line 1 is not supported.",
+		},
+		"invalid data": {
+			content: "@ 3600 IN MX mail.example.com.",
+			wantErr: "Unable to parse zone file: invalid MX record data on line 1.",
+		},
+		"duplicate record": {
+			content: "@ 3600 IN A 203.0.113.10\n@ 300 IN A 203.0.113.10",
+			wantErr: "Unable to parse zone file: A record on line 2 duplicates the record on line 1.",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			request := function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(testCase.content), types.StringValue("example.com")}),
+			}
+			response := function.RunResponse{
+				Result: function.NewResultData(types.ListUnknown(types.ObjectType{AttrTypes: dnsZoneFileRecordAttributeTypes()})),
+			}
+
+			NewParseDNSZoneFileFunction().Run(context.Background(), request, &response)
+
+			require.NotNil(t, response.Error)
+			assert.Equal(t, testCase.wantErr, response.Error.Text)
+		})
+	}
+}
+
+func TestDNSRecordContentFromZoneFile(t *testing.T) {
+	content, err := dnsRecordContentFromZoneFile(helper.ZoneFileRecord{
+		Data: []string{"128", "iodef", `mailto:"security"@example.com`},
+		Type: "CAA",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, dnsRecordContent{Content: `mailto:"security"@example.com`, Flags: 128, Tag: "iodef"}, content)
+}
+
+func TestDNSRecordContentFromZoneFile_CAABackslash(t *testing.T) {
+	content, err := dnsRecordContentFromZoneFile(helper.ZoneFileRecord{
+		Data: []string{"0", "issue", `ca.example.net; account=C:\certs`},
+		Type: "CAA",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, dnsRecordContent{Content: `ca.example.net; account=C:\certs`, Flags: 0, Tag: "issue"}, content)
+}
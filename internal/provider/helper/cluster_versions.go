@@ -1,6 +1,7 @@
 package helper
 
 import (
+	"fmt"
 	"slices"
 	"strings"
 
@@ -45,3 +46,49 @@ func SortVersions[T any](s []T, key func(T) string) {
 		s[i] = d.orig
 	}
 }
+
+// ValidateVersionUpgrade returns an error if moving from current to desired
+// version is a downgrade, changes the major version or skips a minor version.
+// Equal versions are always accepted.
+func ValidateVersionUpgrade(current, desired string) error {
+	currentVersion, err := semver.NewVersion(current)
+	if err != nil {
+		return fmt.Errorf("invalid current version %q: %w", current, err)
+	}
+	desiredVersion, err := semver.NewVersion(desired)
+	if err != nil {
+		return fmt.Errorf("invalid desired version %q: %w", desired, err)
+	}
+
+	switch {
+	case desiredVersion.Equal(currentVersion):
+		return nil
+	case desiredVersion.LessThan(currentVersion):
+		return fmt.Errorf("downgrading from %s to %s is not supported", current, desired)
+	case desiredVersion.Major() != currentVersion.Major():
+		return fmt.Errorf("upgrading major version from %s to %s is not supported", current, desired)
+	case desiredVersion.Minor() > currentVersion.Minor()+1:
+		return fmt.Errorf("upgrading from %s to %s skips at least one minor version, upgrade to %d.%d first",
+			current, desired, currentVersion.Major(), currentVersion.Minor()+1)
+	default:
+		return nil
+	}
+}
+
+// ContainsVersion reports whether versions contains version. Versions are
+// compared semantically, so "v1.31.0" and "1.31.0" are considered equal.
+func ContainsVersion(versions []string, version string) bool {
+	wanted, err := semver.NewVersion(version)
+	for _, v := range versions {
+		if v == version {
+			return true
+		}
+		if err != nil {
+			continue
+		}
+		if candidate, err := semver.NewVersion(v); err == nil && candidate.Equal(wanted) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,69 @@
+package helper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateVersionUpgrade(t *testing.T) {
+	testCases := map[string]struct {
+		current string
+		desired string
+		wantErr string
+	}{
+		"unchanged": {
+			current: "1.31.0",
+			desired: "1.31.0",
+		},
+		"patch upgrade": {
+			current: "1.31.0",
+			desired: "1.31.4",
+		},
+		"minor upgrade": {
+			current: "v1.31.4",
+			desired: "v1.32.0",
+		},
+		"downgrade": {
+			current: "1.32.0",
+			desired: "1.31.4",
+			wantErr: "downgrading from 1.32.0 to 1.31.4 is not supported",
+		},
+		"skipped minor": {
+			current: "1.30.2",
+			desired: "1.32.0",
+			wantErr: "upgrading from 1.30.2 to 1.32.0 skips at least one minor version, upgrade to 1.31 first",
+		},
+		"major upgrade": {
+			current: "1.31.0",
+			desired: "2.0.0",
+			wantErr: "upgrading major version from 1.31.0 to 2.0.0 is not supported",
+		},
+		"invalid desired": {
+			current: "1.31.0",
+			desired: "latest",
+			wantErr: `invalid desired version "latest"`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateVersionUpgrade(testCase.current, testCase.desired)
+
+			if testCase.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, testCase.wantErr)
+		})
+	}
+}
+
+func TestContainsVersion(t *testing.T) {
+	versions := []string{"v1.30.2", "1.31.4"}
+
+	assert.True(t, ContainsVersion(versions, "1.30.2"))
+	assert.True(t, ContainsVersion(versions, "v1.31.4"))
+	assert.False(t, ContainsVersion(versions, "1.32.0"))
+	assert.False(t, ContainsVersion(versions, "latest"))
+}
@@ -0,0 +1,122 @@
+package helper
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ConfigProfile holds the settings of a named profile in the Xelon config
+// file. Settings missing in the profile are empty.
+type ConfigProfile struct {
+	BaseURL         string
+	ClientID        string
+	DefaultCloudID  string
+	DefaultTenantID string
+	Token           string
+}
+
+// DefaultConfigFilePath returns the path of the Xelon config file, which is
+// $XDG_CONFIG_HOME/xelon/config or ~/.config/xelon/config.
+func DefaultConfigFilePath() (string, error) {
+	if configHome := os.Getenv("XDG_CONFIG_HOME"); configHome != "" {
+		return filepath.Join(configHome, "xelon", "config"), nil
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("finding home directory: %w", err)
+	}
+
+	return filepath.Join(home, ".config", "xelon", "config"), nil
+}
+
+// LoadConfigProfile reads the profile with the given name from the config file
+// at path.
+//
+// The config file consists of profiles in INI format:
+//
+//	[production]
+//	base_url          = https://hq.xelon.ch/api/v2/
+//	client_id         = my-client-id
+//	token             = my-token
+//	default_tenant_id = my-tenant-id
+func LoadConfigProfile(path, name string) (*ConfigProfile, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading config file: %w", err)
+	}
+	defer func() { _ = file.Close() }()
+
+	profiles, err := parseConfigProfiles(file)
+	if err != nil {
+		return nil, fmt.Errorf("parsing config file %s: %w", path, err)
+	}
+
+	profile, ok := profiles[name]
+	if !ok {
+		return nil, fmt.Errorf("profile %q not found in config file %s", name, path)
+	}
+
+	return profile, nil
+}
+
+func parseConfigProfiles(r io.Reader) (map[string]*ConfigProfile, error) {
+	profiles := make(map[string]*ConfigProfile)
+	var profile *ConfigProfile
+
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") || strings.HasPrefix(text, ";") {
+			continue
+		}
+
+		if strings.HasPrefix(text, "[") {
+			name, ok := strings.CutSuffix(text[1:], "]")
+			name = strings.TrimSpace(name)
+			if !ok || name == "" {
+				return nil, fmt.Errorf("line %d: invalid profile header %q", line, text)
+			}
+			if _, ok := profiles[name]; ok {
+				return nil, fmt.Errorf("line %d: duplicate profile %q", line, name)
+			}
+			profile = &ConfigProfile{}
+			profiles[name] = profile
+			continue
+		}
+
+		key, value, ok := strings.Cut(text, "=")
+		if !ok {
+			return nil, fmt.Errorf("line %d: expected key = value", line)
+		}
+		if profile == nil {
+			return nil, fmt.Errorf("line %d: setting outside of a profile", line)
+		}
+		key = strings.TrimSpace(key)
+		value = strings.Trim(strings.TrimSpace(value), `"`)
+
+		switch key {
+		case "base_url":
+			profile.BaseURL = value
+		case "client_id":
+			profile.ClientID = value
+		case "default_cloud_id":
+			profile.DefaultCloudID = value
+		case "default_tenant_id":
+			profile.DefaultTenantID = value
+		case "token":
+			profile.Token = value
+		default:
+			return nil, fmt.Errorf("line %d: unknown setting %q", line, key)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return profiles, nil
+}
@@ -0,0 +1,98 @@
+package helper
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseConfigProfiles(t *testing.T) {
+	content := `
+# shared credentials
+[default]
+token = default-token
+
+[staging]
+base_url          = https://staging.xelon.ch/api/v2/
+client_id         = "staging-client"
+token             = staging-token
+; tenant used by resources without tenant_id
+default_tenant_id = tenant-123
+default_cloud_id  = cloud-456
+`
+
+	profiles, err := parseConfigProfiles(strings.NewReader(content))
+
+	require.NoError(t, err)
+	assert.Equal(t, map[string]*ConfigProfile{
+		"default": {Token: "default-token"},
+		"staging": {
+			BaseURL:         "https://staging.xelon.ch/api/v2/",
+			ClientID:        "staging-client",
+			DefaultCloudID:  "cloud-456",
+			DefaultTenantID: "tenant-123",
+			Token:           "staging-token",
+		},
+	}, profiles)
+}
+
+func TestParseConfigProfiles_Invalid(t *testing.T) {
+	testCases := map[string]struct {
+		content string
+		wantErr string
+	}{
+		"setting outside of profile": {
+			content: "token = secret",
+			wantErr: "line 1: setting outside of a profile",
+		},
+		"invalid header": {
+			content: "[staging",
+			wantErr: `line 1: invalid profile header "[staging"`,
+		},
+		"duplicate profile": {
+			content: "[staging]\n[staging]",
+			wantErr: `line 2: duplicate profile "staging"`,
+		},
+		"missing value": {
+			content: "[staging]\ntoken",
+			wantErr: "line 2: expected key = value",
+		},
+		"unknown setting": {
+			content: "[staging]\ntenant_id = 123",
+			wantErr: `line 2: unknown setting "tenant_id"`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			_, err := parseConfigProfiles(strings.NewReader(testCase.content))
+
+			assert.EqualError(t, err, testCase.wantErr)
+		})
+	}
+}
+
+func TestLoadConfigProfile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config")
+	require.NoError(t, os.WriteFile(path, []byte("[staging]\ntoken = staging-token\n"), 0o600))
+
+	profile, err := LoadConfigProfile(path, "staging")
+	require.NoError(t, err)
+	assert.Equal(t, &ConfigProfile{Token: "staging-token"}, profile)
+
+	_, err = LoadConfigProfile(path, "production")
+	assert.EqualError(t, err, `profile "production" not found in config file `+path)
+}
+
+func TestDefaultConfigFilePath(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", "/etc/config")
+
+	path, err := DefaultConfigFilePath()
+
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join("/etc/config", "xelon", "config"), path)
+}
@@ -10,11 +10,11 @@ import (
 	"github.com/Xelon-AG/xelon-sdk-go/xelon"
 )
 
-func WaitDevicePowerStateOn(ctx context.Context, client *xelon.Client, deviceID string) error {
+func WaitDevicePowerStateOn(ctx context.Context, client *xelon.Client, deviceID string, timeout time.Duration) error {
 	stateConf := &retry.StateChangeConf{
 		Pending:    []string{deviceStatePowerOff},
 		Target:     []string{deviceStatePowerOn},
-		Timeout:    10 * time.Minute,
+		Timeout:    timeout,
 		MinTimeout: 5 * time.Second,
 		Delay:      3 * time.Second,
 		Refresh:    statusDevicePowerState(ctx, client, deviceID),
@@ -26,11 +26,11 @@ func WaitDevicePowerStateOn(ctx context.Context, client *xelon.Client, deviceID
 	return nil
 }
 
-func WaitDevicePowerStateOff(ctx context.Context, client *xelon.Client, deviceID string) error {
+func WaitDevicePowerStateOff(ctx context.Context, client *xelon.Client, deviceID string, timeout time.Duration) error {
 	stateConf := &retry.StateChangeConf{
 		Pending:    []string{deviceStatePowerOn},
 		Target:     []string{deviceStatePowerOff},
-		Timeout:    10 * time.Minute,
+		Timeout:    timeout,
 		MinTimeout: 5 * time.Second,
 		Delay:      3 * time.Second,
 		Refresh:    statusDevicePowerState(ctx, client, deviceID),
@@ -42,11 +42,11 @@ func WaitDevicePowerStateOff(ctx context.Context, client *xelon.Client, deviceID
 	return nil
 }
 
-func WaitDeviceStateReady(ctx context.Context, client *xelon.Client, deviceID string) error {
+func WaitDeviceStateReady(ctx context.Context, client *xelon.Client, deviceID string, timeout time.Duration) error {
 	stateConf := &retry.StateChangeConf{
 		Pending:    []string{deviceStateProvisioning, deviceStateReadyForBasicUse, deviceStateUpdating},
 		Target:     []string{deviceStateReady},
-		Timeout:    10 * time.Minute,
+		Timeout:    timeout,
 		MinTimeout: 5 * time.Second,
 		Delay:      3 * time.Second,
 		Refresh:    statusDeviceState(ctx, client, deviceID),
@@ -58,11 +58,11 @@ func WaitDeviceStateReady(ctx context.Context, client *xelon.Client, deviceID st
 	return nil
 }
 
-func WaitDeviceSnapshotsDeleted(ctx context.Context, client *xelon.Client, deviceID string) error {
+func WaitDeviceSnapshotsDeleted(ctx context.Context, client *xelon.Client, deviceID string, timeout time.Duration) error {
 	stateConf := &retry.StateChangeConf{
 		Pending:    []string{deviceDiskSnapshotsExist},
 		Target:     []string{deviceDiskSnapshotsMissing},
-		Timeout:    10 * time.Minute,
+		Timeout:    timeout,
 		MinTimeout: 5 * time.Second,
 		Delay:      3 * time.Second,
 		Refresh:    statusDeviceSnapshotsEmpty(ctx, client, deviceID),
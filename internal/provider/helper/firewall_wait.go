@@ -10,11 +10,11 @@ import (
 	"github.com/Xelon-AG/xelon-sdk-go/xelon"
 )
 
-func WaitFirewallStateReady(ctx context.Context, client *xelon.Client, firewallID string) error {
+func WaitFirewallStateReady(ctx context.Context, client *xelon.Client, firewallID string, timeout time.Duration) error {
 	stateConf := &retry.StateChangeConf{
 		Pending:    []string{firewallStateProvisioning},
 		Target:     []string{firewallStateReady},
-		Timeout:    10 * time.Minute,
+		Timeout:    timeout,
 		MinTimeout: 5 * time.Second,
 		Delay:      3 * time.Second,
 		Refresh:    statusFirewallState(ctx, client, firewallID),
@@ -0,0 +1,86 @@
+package helper
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// TransportConfig holds the TLS and proxy settings for connecting to Xelon HQ.
+type TransportConfig struct {
+	// CACertPEM holds additional CA certificates trusted besides the system ones.
+	CACertPEM []byte
+	// ClientCertPEM and ClientKeyPEM hold the client certificate for mutual TLS.
+	ClientCertPEM      []byte
+	ClientKeyPEM       []byte
+	InsecureSkipVerify bool
+	// ProxyURL is the proxy for all requests, if empty the proxy is taken from
+	// the HTTP_PROXY, HTTPS_PROXY, and NO_PROXY environment variables.
+	ProxyURL string
+}
+
+// NewHTTPTransport returns a copy of http.DefaultTransport using the TLS and
+// proxy settings of config.
+func NewHTTPTransport(config TransportConfig) (*http.Transport, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: config.InsecureSkipVerify,
+		MinVersion:         tls.VersionTLS12,
+	}
+
+	if len(config.CACertPEM) > 0 {
+		rootCAs, err := x509.SystemCertPool()
+		if err != nil {
+			rootCAs = x509.NewCertPool()
+		}
+		if !rootCAs.AppendCertsFromPEM(config.CACertPEM) {
+			return nil, errors.New("no valid PEM encoded CA certificate found")
+		}
+		tlsConfig.RootCAs = rootCAs
+	}
+
+	if len(config.ClientCertPEM) > 0 || len(config.ClientKeyPEM) > 0 {
+		if len(config.ClientCertPEM) == 0 || len(config.ClientKeyPEM) == 0 {
+			return nil, errors.New("client certificate and key must be set together")
+		}
+		certificate, err := tls.X509KeyPair(config.ClientCertPEM, config.ClientKeyPEM)
+		if err != nil {
+			return nil, fmt.Errorf("loading client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+	}
+	transport.TLSClientConfig = tlsConfig
+
+	if config.ProxyURL != "" {
+		proxyURL, err := url.Parse(config.ProxyURL)
+		if err != nil {
+			// the error of url.Parse contains the URL with its password
+			var urlErr *url.Error
+			if errors.As(err, &urlErr) {
+				err = urlErr.Err
+			}
+			return nil, fmt.Errorf("parsing proxy URL: %w", err)
+		}
+		switch proxyURL.Scheme {
+		case "http", "https", "socks5":
+		default:
+			return nil, fmt.Errorf("proxy URL %q must use one of the schemes http, https, or socks5", proxyURL.Redacted())
+		}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	return transport, nil
+}
+
+// RedactedURL returns rawURL with its password replaced by "xxxxx", e.g. to
+// log a proxy URL. Invalid URLs are returned as empty string.
+func RedactedURL(rawURL string) string {
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return ""
+	}
+	return parsed.Redacted()
+}
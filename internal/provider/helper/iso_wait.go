@@ -10,11 +10,11 @@ import (
 	"github.com/Xelon-AG/xelon-sdk-go/xelon"
 )
 
-func WaitISOStateReady(ctx context.Context, client *xelon.Client, isoID string) error {
+func WaitISOStateReady(ctx context.Context, client *xelon.Client, isoID string, timeout time.Duration) error {
 	stateConf := &retry.StateChangeConf{
 		Pending:    []string{isoStateCreating},
 		Target:     []string{isoStateReady},
-		Timeout:    10 * time.Minute,
+		Timeout:    timeout,
 		MinTimeout: 5 * time.Second,
 		Delay:      3 * time.Second,
 		Refresh:    statusISOState(ctx, client, isoID),
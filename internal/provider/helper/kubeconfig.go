@@ -0,0 +1,168 @@
+package helper
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"slices"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Kubeconfig holds the connection settings of the current context of a
+// kubeconfig, with all certificates and keys PEM encoded.
+type Kubeconfig struct {
+	ClientCertificate    string
+	ClientKey            string
+	ClusterCACertificate string
+	Host                 string
+}
+
+type kubeconfigFile struct {
+	Clusters       []kubeconfigCluster `yaml:"clusters"`
+	Contexts       []kubeconfigContext `yaml:"contexts"`
+	CurrentContext string              `yaml:"current-context"`
+	Users          []kubeconfigUser    `yaml:"users"`
+}
+
+type kubeconfigCluster struct {
+	Cluster struct {
+		CertificateAuthorityData string `yaml:"certificate-authority-data"`
+		Server                   string `yaml:"server"`
+	} `yaml:"cluster"`
+	Name string `yaml:"name"`
+}
+
+type kubeconfigContext struct {
+	Context struct {
+		Cluster string `yaml:"cluster"`
+		User    string `yaml:"user"`
+	} `yaml:"context"`
+	Name string `yaml:"name"`
+}
+
+type kubeconfigUser struct {
+	Name string `yaml:"name"`
+	User struct {
+		ClientCertificateData string `yaml:"client-certificate-data"`
+		ClientKeyData         string `yaml:"client-key-data"`
+	} `yaml:"user"`
+}
+
+// ParseKubeconfig returns the connection settings of the current context of
+// the kubeconfig, or of its only context if no current context is set.
+func ParseKubeconfig(raw string) (*Kubeconfig, error) {
+	var file kubeconfigFile
+	if err := yaml.Unmarshal([]byte(raw), &file); err != nil {
+		return nil, fmt.Errorf("parsing kubeconfig: %w", err)
+	}
+
+	contextName := file.CurrentContext
+	if contextName == "" {
+		if len(file.Contexts) != 1 {
+			return nil, errors.New("kubeconfig has no current context")
+		}
+		contextName = file.Contexts[0].Name
+	}
+
+	contextIndex := slices.IndexFunc(file.Contexts, func(c kubeconfigContext) bool { return c.Name == contextName })
+	if contextIndex < 0 {
+		return nil, fmt.Errorf("context %q not found in kubeconfig", contextName)
+	}
+	kubeContext := file.Contexts[contextIndex].Context
+
+	clusterIndex := slices.IndexFunc(file.Clusters, func(c kubeconfigCluster) bool { return c.Name == kubeContext.Cluster })
+	if clusterIndex < 0 {
+		return nil, fmt.Errorf("cluster %q not found in kubeconfig", kubeContext.Cluster)
+	}
+	cluster := file.Clusters[clusterIndex].Cluster
+
+	userIndex := slices.IndexFunc(file.Users, func(u kubeconfigUser) bool { return u.Name == kubeContext.User })
+	if userIndex < 0 {
+		return nil, fmt.Errorf("user %q not found in kubeconfig", kubeContext.User)
+	}
+	user := file.Users[userIndex].User
+
+	kubeconfig := &Kubeconfig{Host: cluster.Server}
+	var err error
+	if kubeconfig.ClusterCACertificate, err = decodeBase64PEM("certificate-authority-data", cluster.CertificateAuthorityData); err != nil {
+		return nil, err
+	}
+	if kubeconfig.ClientCertificate, err = decodeBase64PEM("client-certificate-data", user.ClientCertificateData); err != nil {
+		return nil, err
+	}
+	if kubeconfig.ClientKey, err = decodeBase64PEM("client-key-data", user.ClientKeyData); err != nil {
+		return nil, err
+	}
+
+	return kubeconfig, nil
+}
+
+// Talosconfig holds the settings of the current context of a talosconfig,
+// with all certificates and keys PEM encoded.
+type Talosconfig struct {
+	CACertificate     string
+	ClientCertificate string
+	ClientKey         string
+	Endpoints         []string
+	Nodes             []string
+}
+
+type talosconfigFile struct {
+	Context  string `yaml:"context"`
+	Contexts map[string]struct {
+		CA        string   `yaml:"ca"`
+		Crt       string   `yaml:"crt"`
+		Endpoints []string `yaml:"endpoints"`
+		Key       string   `yaml:"key"`
+		Nodes     []string `yaml:"nodes"`
+	} `yaml:"contexts"`
+}
+
+// ParseTalosconfig returns the settings of the current context of the
+// talosconfig, or of its only context if no current context is set.
+func ParseTalosconfig(raw string) (*Talosconfig, error) {
+	var file talosconfigFile
+	if err := yaml.Unmarshal([]byte(raw), &file); err != nil {
+		return nil, fmt.Errorf("parsing talosconfig: %w", err)
+	}
+
+	contextName := file.Context
+	if contextName == "" {
+		if len(file.Contexts) != 1 {
+			return nil, errors.New("talosconfig has no current context")
+		}
+		for name := range file.Contexts {
+			contextName = name
+		}
+	}
+	talosContext, ok := file.Contexts[contextName]
+	if !ok {
+		return nil, fmt.Errorf("context %q not found in talosconfig", contextName)
+	}
+
+	talosconfig := &Talosconfig{
+		Endpoints: talosContext.Endpoints,
+		Nodes:     talosContext.Nodes,
+	}
+	var err error
+	if talosconfig.CACertificate, err = decodeBase64PEM("ca", talosContext.CA); err != nil {
+		return nil, err
+	}
+	if talosconfig.ClientCertificate, err = decodeBase64PEM("crt", talosContext.Crt); err != nil {
+		return nil, err
+	}
+	if talosconfig.ClientKey, err = decodeBase64PEM("key", talosContext.Key); err != nil {
+		return nil, err
+	}
+
+	return talosconfig, nil
+}
+
+func decodeBase64PEM(field, value string) (string, error) {
+	decoded, err := base64.StdEncoding.DecodeString(value)
+	if err != nil {
+		return "", fmt.Errorf("decoding %s: %w", field, err)
+	}
+	return string(decoded), nil
+}
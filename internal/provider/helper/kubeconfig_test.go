@@ -0,0 +1,117 @@
+package helper
+
+import (
+	"encoding/base64"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func encodeTestPEM(content string) string {
+	return base64.StdEncoding.EncodeToString([]byte(content))
+}
+
+func TestParseKubeconfig(t *testing.T) {
+	raw := fmt.Sprintf(`
+apiVersion: v1
+kind: Config
+clusters:
+  - name: other
+    cluster:
+      server: https://other.example.com:6443
+  - name: xks
+    cluster:
+      server: https://xks.example.com:6443
+      certificate-authority-data: %[1]s
+contexts:
+  - name: admin@xks
+    context:
+      cluster: xks
+      user: admin@xks
+current-context: admin@xks
+users:
+  - name: admin@xks
+    user:
+      client-certificate-data: %[2]s
+      client-key-data: %[3]s
+`, encodeTestPEM("CA CERTIFICATE"), encodeTestPEM("CLIENT CERTIFICATE"), encodeTestPEM("CLIENT KEY"))
+
+	kubeconfig, err := ParseKubeconfig(raw)
+
+	require.NoError(t, err)
+	assert.Equal(t, &Kubeconfig{
+		ClientCertificate:    "CLIENT CERTIFICATE",
+		ClientKey:            "CLIENT KEY",
+		ClusterCACertificate: "CA CERTIFICATE",
+		Host:                 "https://xks.example.com:6443",
+	}, kubeconfig)
+}
+
+func TestParseKubeconfig_Invalid(t *testing.T) {
+	testCases := map[string]struct {
+		raw     string
+		wantErr string
+	}{
+		"no current context": {
+			raw:     "contexts: []",
+			wantErr: "kubeconfig has no current context",
+		},
+		"missing context": {
+			raw:     "current-context: admin",
+			wantErr: `context "admin" not found in kubeconfig`,
+		},
+		"missing cluster": {
+			raw:     "contexts: [{name: admin, context: {cluster: xks, user: admin}}]",
+			wantErr: `cluster "xks" not found in kubeconfig`,
+		},
+		"missing user": {
+			raw:     "contexts: [{name: admin, context: {cluster: xks, user: admin}}]\nclusters: [{name: xks}]",
+			wantErr: `user "admin" not found in kubeconfig`,
+		},
+		"invalid certificate": {
+			raw: "contexts: [{name: admin, context: {cluster: xks, user: admin}}]\n" +
+				"clusters: [{name: xks, cluster: {certificate-authority-data: '***'}}]\nusers: [{name: admin}]",
+			wantErr: "decoding certificate-authority-data: illegal base64 data at input byte 0",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			_, err := ParseKubeconfig(testCase.raw)
+
+			assert.EqualError(t, err, testCase.wantErr)
+		})
+	}
+}
+
+func TestParseTalosconfig(t *testing.T) {
+	raw := fmt.Sprintf(`
+context: xks
+contexts:
+  xks:
+    endpoints:
+      - 10.0.0.10
+    nodes:
+      - 10.0.0.11
+      - 10.0.0.12
+    ca: %[1]s
+    crt: %[2]s
+    key: %[3]s
+`, encodeTestPEM("CA CERTIFICATE"), encodeTestPEM("CLIENT CERTIFICATE"), encodeTestPEM("CLIENT KEY"))
+
+	talosconfig, err := ParseTalosconfig(raw)
+
+	require.NoError(t, err)
+	assert.Equal(t, &Talosconfig{
+		CACertificate:     "CA CERTIFICATE",
+		ClientCertificate: "CLIENT CERTIFICATE",
+		ClientKey:         "CLIENT KEY",
+		Endpoints:         []string{"10.0.0.10"},
+		Nodes:             []string{"10.0.0.11", "10.0.0.12"},
+	}, talosconfig)
+
+	_, err = ParseTalosconfig("context: other\ncontexts: {xks: {}}")
+	assert.EqualError(t, err, `context "other" not found in talosconfig`)
+}
@@ -0,0 +1,93 @@
+package helper
+
+import (
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// LimitTransport is a http.RoundTripper limiting the number of concurrent
+// requests and the request rate. All requests sent through the same transport
+// share these limits, e.g. all resources and waiters using the same client.
+type LimitTransport struct {
+	base      http.RoundTripper
+	interval  time.Duration
+	semaphore chan struct{}
+
+	mu   sync.Mutex
+	next time.Time
+}
+
+// NewLimitTransport returns a LimitTransport sending requests with base,
+// http.DefaultTransport if nil. A maxConcurrentRequests or requestsPerSecond
+// of 0 disables the corresponding limit.
+func NewLimitTransport(base http.RoundTripper, maxConcurrentRequests int, requestsPerSecond float64) *LimitTransport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	t := &LimitTransport{base: base}
+	if maxConcurrentRequests > 0 {
+		t.semaphore = make(chan struct{}, maxConcurrentRequests)
+	}
+	if requestsPerSecond > 0 {
+		t.interval = time.Duration(float64(time.Second) / requestsPerSecond)
+	}
+
+	return t
+}
+
+func (t *LimitTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	ctx := request.Context()
+	start := time.Now()
+
+	if t.semaphore != nil {
+		select {
+		case t.semaphore <- struct{}{}:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		defer func() { <-t.semaphore }()
+	}
+
+	if wait := t.reserve(time.Now()); wait > 0 {
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+
+	if queueWait := time.Since(start); queueWait >= time.Millisecond {
+		tflog.Debug(ctx, "Waited for Xelon API request limits", map[string]any{
+			"method":     request.Method,
+			"queue_wait": queueWait.String(),
+			"url":        request.URL.String(),
+		})
+	}
+
+	return t.base.RoundTrip(request)
+}
+
+// reserve reserves the next free slot for sending a request and returns the
+// time to wait until then. Slots are spaced evenly by the rate limit interval.
+func (t *LimitTransport) reserve(now time.Time) time.Duration {
+	if t.interval <= 0 {
+		return 0
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	slot := now
+	if t.next.After(now) {
+		slot = t.next
+	}
+	t.next = slot.Add(t.interval)
+
+	return slot.Sub(now)
+}
@@ -0,0 +1,89 @@
+package helper
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLimitTransport_MaxConcurrentRequests(t *testing.T) {
+	var inFlight, maxInFlight atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		current := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			observed := maxInFlight.Load()
+			if current <= observed || maxInFlight.CompareAndSwap(observed, current) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+	client := &http.Client{Transport: NewLimitTransport(nil, 2, 0)}
+
+	var wg sync.WaitGroup
+	for range 6 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			response, err := client.Get(server.URL)
+			if assert.NoError(t, err) {
+				_ = response.Body.Close()
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(2), maxInFlight.Load())
+}
+
+func TestLimitTransport_RequestsPerSecond(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+	client := &http.Client{Transport: NewLimitTransport(nil, 0, 50)}
+
+	start := time.Now()
+	for range 5 {
+		response, err := client.Get(server.URL)
+		require.NoError(t, err)
+		_ = response.Body.Close()
+	}
+
+	// the first request is sent immediately, the following ones every 20ms
+	assert.GreaterOrEqual(t, time.Since(start), 80*time.Millisecond)
+}
+
+func TestLimitTransport_Reserve(t *testing.T) {
+	transport := NewLimitTransport(nil, 0, 10)
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, time.Duration(0), transport.reserve(now))
+	assert.Equal(t, 100*time.Millisecond, transport.reserve(now))
+	assert.Equal(t, 150*time.Millisecond, transport.reserve(now.Add(50*time.Millisecond)))
+	assert.Equal(t, time.Duration(0), transport.reserve(now.Add(time.Second)))
+
+	assert.Equal(t, time.Duration(0), NewLimitTransport(nil, 0, 0).reserve(now))
+}
+
+func TestLimitTransport_ContextCanceled(t *testing.T) {
+	transport := NewLimitTransport(nil, 0, 0.1)
+	transport.reserve(time.Now())
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	request, _ := http.NewRequestWithContext(ctx, http.MethodGet, "http://localhost", nil)
+
+	_, err := transport.RoundTrip(request)
+
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
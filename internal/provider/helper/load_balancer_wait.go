@@ -10,11 +10,11 @@ import (
 	"github.com/Xelon-AG/xelon-sdk-go/xelon"
 )
 
-func WaitLoadBalancerStateReady(ctx context.Context, client *xelon.Client, loadBalancerID string) error {
+func WaitLoadBalancerStateReady(ctx context.Context, client *xelon.Client, loadBalancerID string, timeout time.Duration) error {
 	stateConf := &retry.StateChangeConf{
 		Pending:    []string{loadBalancerStateProvisioning},
 		Target:     []string{loadBalancerStateReady},
-		Timeout:    10 * time.Minute,
+		Timeout:    timeout,
 		MinTimeout: 5 * time.Second,
 		Delay:      3 * time.Second,
 		Refresh:    statusLoadBalancerState(ctx, client, loadBalancerID),
@@ -0,0 +1,183 @@
+package helper
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// HTTPLogSubsystem is the tflog subsystem logging the Xelon API requests at
+// TRACE level. It inherits the provider log level, which can be overridden
+// with the TF_LOG_PROVIDER_XELON_HTTP environment variable.
+const HTTPLogSubsystem = "xelon_http"
+
+const redactedValue = "***"
+
+// redactedJSONKeys are the keys of secret values in request and response
+// bodies, compared ignoring case, underscores, and dashes. They also match as
+// suffix, e.g. "token" matches "access_token" and "refreshToken".
+var redactedJSONKeys = []string{"kubeconfig", "password", "secretaccesskey", "talosconfig", "token", "userdata"}
+
+// LoggingTransport is a http.RoundTripper logging method, URL, status,
+// duration, request ID, and the bodies of all requests to the xelon_http
+// subsystem. Secrets in JSON bodies are masked and other bodies are omitted,
+// as they could contain e.g. a kubeconfig.
+type LoggingTransport struct {
+	// Base is the transport sending the requests, http.DefaultTransport if nil.
+	Base http.RoundTripper
+}
+
+func (t *LoggingTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	ctx := tflog.NewSubsystem(request.Context(), HTTPLogSubsystem, tflog.WithLevelFromEnv("TF_LOG_PROVIDER_XELON_HTTP"))
+	fields := map[string]any{
+		"method": request.Method,
+		"url":    request.URL.Redacted(),
+	}
+	if body := requestBodyForLog(request); body != "" {
+		fields["request_body"] = body
+	}
+	tflog.SubsystemTrace(ctx, HTTPLogSubsystem, "Sending Xelon API request", fields)
+
+	start := time.Now()
+	response, err := base.RoundTrip(request)
+	fields["duration_ms"] = time.Since(start).Milliseconds()
+	delete(fields, "request_body")
+	if err != nil {
+		fields["error"] = err.Error()
+		tflog.SubsystemTrace(ctx, HTTPLogSubsystem, "Xelon API request failed", fields)
+		return nil, err
+	}
+
+	fields["status"] = response.StatusCode
+	if requestID := response.Header.Get("X-Request-Id"); requestID != "" {
+		fields["request_id"] = requestID
+	}
+	body, err := responseBodyForLog(response)
+	if err != nil {
+		return nil, err
+	}
+	if body != "" {
+		fields["response_body"] = body
+	}
+	tflog.SubsystemTrace(ctx, HTTPLogSubsystem, "Received Xelon API response", fields)
+
+	return response, nil
+}
+
+// requestBodyForLog returns the masked request body, without consuming it.
+func requestBodyForLog(request *http.Request) string {
+	if request.Body == nil || request.Body == http.NoBody {
+		return ""
+	}
+	if request.GetBody == nil || !isJSONContentType(request.Header.Get("Content-Type")) {
+		return omittedBody(request.Header.Get("Content-Type"), request.ContentLength)
+	}
+
+	body, err := request.GetBody()
+	if err != nil {
+		return ""
+	}
+	defer func() { _ = body.Close() }()
+	content, err := io.ReadAll(body)
+	if err != nil {
+		return ""
+	}
+
+	return redactJSON(content)
+}
+
+// responseBodyForLog returns the masked response body, replacing the read
+// body with a copy.
+func responseBodyForLog(response *http.Response) (string, error) {
+	if !isJSONContentType(response.Header.Get("Content-Type")) {
+		return omittedBody(response.Header.Get("Content-Type"), response.ContentLength), nil
+	}
+
+	content, err := io.ReadAll(response.Body)
+	_ = response.Body.Close()
+	if err != nil {
+		return "", err
+	}
+	response.Body = io.NopCloser(bytes.NewReader(content))
+
+	return redactJSON(content), nil
+}
+
+func omittedBody(contentType string, contentLength int64) string {
+	if contentLength == 0 {
+		return ""
+	}
+	if contentLength < 0 {
+		return fmt.Sprintf("<omitted %s body>", contentType)
+	}
+	return fmt.Sprintf("<omitted %s body of %d bytes>", contentType, contentLength)
+}
+
+func isJSONContentType(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	return mediaType == "application/json" || strings.HasSuffix(mediaType, "+json")
+}
+
+// redactJSON returns the JSON content with the values of secret keys, e.g.
+// token, password, or kubeconfig, masked. Invalid JSON is omitted entirely.
+func redactJSON(content []byte) string {
+	if len(bytes.TrimSpace(content)) == 0 {
+		return ""
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(content))
+	decoder.UseNumber()
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return fmt.Sprintf("<omitted invalid JSON body of %d bytes>", len(content))
+	}
+
+	redacted, err := json.Marshal(redactJSONValue(value))
+	if err != nil {
+		return fmt.Sprintf("<omitted JSON body of %d bytes>", len(content))
+	}
+	return string(redacted)
+}
+
+func redactJSONValue(value any) any {
+	switch value := value.(type) {
+	case map[string]any:
+		for key, nested := range value {
+			if isSecretJSONKey(key) && nested != nil {
+				value[key] = redactedValue
+				continue
+			}
+			value[key] = redactJSONValue(nested)
+		}
+	case []any:
+		for i, nested := range value {
+			value[i] = redactJSONValue(nested)
+		}
+	}
+	return value
+}
+
+func isSecretJSONKey(key string) bool {
+	key = strings.NewReplacer("_", "", "-", "").Replace(strings.ToLower(key))
+	for _, secretKey := range redactedJSONKeys {
+		if strings.HasSuffix(key, secretKey) {
+			return true
+		}
+	}
+	return false
+}
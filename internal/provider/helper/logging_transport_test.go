@@ -0,0 +1,101 @@
+package helper
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedactJSON(t *testing.T) {
+	testCases := map[string]struct {
+		content string
+		want    string
+	}{
+		"empty": {
+			content: "",
+			want:    "",
+		},
+		"secrets": {
+			content: `{"name":"test","password":"secret","user_data":"#cloud-config","nested":{"accessToken":"secret","secret_access_key":"secret"}}`,
+			want:    `{"name":"test","nested":{"accessToken":"***","secret_access_key":"***"},"password":"***","user_data":"***"}`,
+		},
+		"kubeconfig": {
+			content: `{"data":[{"id":1,"kubeconfig":{"clusters":[]},"talosconfig":"context: test"}]}`,
+			want:    `{"data":[{"id":1,"kubeconfig":"***","talosconfig":"***"}]}`,
+		},
+		"null secret": {
+			content: `{"token":null}`,
+			want:    `{"token":null}`,
+		},
+		"invalid JSON": {
+			content: `token: secret`,
+			want:    "<omitted invalid JSON body of 13 bytes>",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, testCase.want, redactJSON([]byte(testCase.content)))
+		})
+	}
+}
+
+func TestLoggingTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Request-Id", "request-123")
+		if r.URL.Path == "/kubeconfig" {
+			w.Header().Set("Content-Type", "application/yaml")
+		}
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"id":"1","password":"response-secret"}`))
+	}))
+	t.Cleanup(server.Close)
+	client := &http.Client{Transport: &LoggingTransport{}}
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+	request, _ := http.NewRequestWithContext(ctx, http.MethodPost, server.URL+"/devices", strings.NewReader(`{"password":"request-secret"}`))
+	request.Header.Set("Content-Type", "application/json")
+	response, err := client.Do(request)
+
+	require.NoError(t, err)
+	body, _ := io.ReadAll(response.Body)
+	_ = response.Body.Close()
+	assert.JSONEq(t, `{"id":"1","password":"response-secret"}`, string(body))
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	assert.Equal(t, "Sending Xelon API request", entries[0]["@message"])
+	assert.Equal(t, "POST", entries[0]["method"])
+	assert.Equal(t, server.URL+"/devices", entries[0]["url"])
+	assert.Equal(t, `{"password":"***"}`, entries[0]["request_body"])
+	assert.Equal(t, "Received Xelon API response", entries[1]["@message"])
+	assert.Equal(t, float64(http.StatusCreated), entries[1]["status"])
+	assert.Equal(t, "request-123", entries[1]["request_id"])
+	assert.Equal(t, `{"id":"1","password":"***"}`, entries[1]["response_body"])
+	assert.Contains(t, entries[1], "duration_ms")
+	assert.NotContains(t, output.String(), "secret")
+
+	t.Run("non-JSON body", func(t *testing.T) {
+		output.Reset()
+		request, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"/kubeconfig", nil)
+		response, err := client.Do(request)
+
+		require.NoError(t, err)
+		_ = response.Body.Close()
+		entries, err := tflogtest.MultilineJSONDecode(&output)
+		require.NoError(t, err)
+		require.Len(t, entries, 2)
+		assert.Equal(t, "<omitted application/yaml body of 39 bytes>", entries[1]["response_body"])
+	})
+}
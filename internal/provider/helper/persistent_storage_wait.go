@@ -10,11 +10,11 @@ import (
 	"github.com/Xelon-AG/xelon-sdk-go/xelon"
 )
 
-func WaitPersistentStorageStateFormatted(ctx context.Context, client *xelon.Client, persistentStorageID string) error {
+func WaitPersistentStorageStateFormatted(ctx context.Context, client *xelon.Client, persistentStorageID string, timeout time.Duration) error {
 	stateConf := &retry.StateChangeConf{
 		Pending:    []string{persistentStorageStateUnformatted},
 		Target:     []string{persistentStorageStateFormatted},
-		Timeout:    10 * time.Minute,
+		Timeout:    timeout,
 		MinTimeout: 5 * time.Second,
 		Delay:      3 * time.Second,
 		Refresh:    statusPersistentStorageFormattedState(ctx, client, persistentStorageID),
@@ -26,11 +26,11 @@ func WaitPersistentStorageStateFormatted(ctx context.Context, client *xelon.Clie
 	return nil
 }
 
-func WaitPersistentStorageStateReady(ctx context.Context, client *xelon.Client, persistentStorageID string) error {
+func WaitPersistentStorageStateReady(ctx context.Context, client *xelon.Client, persistentStorageID string, timeout time.Duration) error {
 	stateConf := &retry.StateChangeConf{
 		Pending:    []string{persistentStorageStateProvisioning},
 		Target:     []string{persistentStorageStateReady},
-		Timeout:    10 * time.Minute,
+		Timeout:    timeout,
 		MinTimeout: 5 * time.Second,
 		Delay:      3 * time.Second,
 		Refresh:    statusPersistentStorageReadyState(ctx, client, persistentStorageID),
@@ -0,0 +1,69 @@
+package helper
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// providerDefaultModifier implements the plan modifier.
+type providerDefaultModifier struct {
+	lookup            func() types.String
+	providerAttribute string
+	required          bool
+}
+
+// ProviderDefault returns a plan modifier that sets the value of an omitted
+// attribute to the default configured by providerAttribute in the provider
+// configuration, e.g. default_tenant_id. The default is looked up at plan time,
+// as it is only known once the provider is configured.
+//
+// Without provider default, a required attribute is reported as missing and
+// an optional one keeps its prior state value.
+func ProviderDefault(providerAttribute string, lookup func() types.String, required bool) planmodifier.String {
+	return providerDefaultModifier{
+		lookup:            lookup,
+		providerAttribute: providerAttribute,
+		required:          required,
+	}
+}
+
+func (m providerDefaultModifier) Description(_ context.Context) string {
+	return fmt.Sprintf("Defaults to the provider %s if not configured.", m.providerAttribute)
+}
+
+func (m providerDefaultModifier) MarkdownDescription(_ context.Context) string {
+	return fmt.Sprintf("Defaults to the provider `%s` if not configured.", m.providerAttribute)
+}
+
+func (m providerDefaultModifier) PlanModifyString(_ context.Context, request planmodifier.StringRequest, response *planmodifier.StringResponse) {
+	// do nothing on resource destroy
+	if request.Plan.Raw.IsNull() {
+		return
+	}
+	// do nothing if the value is configured
+	if !request.ConfigValue.IsNull() {
+		return
+	}
+
+	if defaultValue := m.lookup(); defaultValue.IsUnknown() || defaultValue.ValueString() != "" {
+		response.PlanValue = defaultValue
+		return
+	}
+
+	if m.required {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Missing required argument",
+			fmt.Sprintf("The argument %q is required if %q is not set in the provider configuration.", request.Path, m.providerAttribute),
+		)
+		return
+	}
+
+	// keep the prior state value, the same as UseStateForUnknown
+	if !request.State.Raw.IsNull() && !request.StateValue.IsNull() {
+		response.PlanValue = request.StateValue
+	}
+}
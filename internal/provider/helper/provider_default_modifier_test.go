@@ -0,0 +1,109 @@
+package helper
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProviderDefaultModifier(t *testing.T) {
+	t.Parallel()
+
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"tenant_id": tftypes.String}}
+	existing := tftypes.NewValue(objectType, map[string]tftypes.Value{
+		"tenant_id": tftypes.NewValue(tftypes.String, "state-tenant"),
+	})
+	absent := tftypes.NewValue(objectType, nil)
+
+	type testCase struct {
+		configValue       types.String
+		defaultValue      types.String
+		required          bool
+		state             tftypes.Value
+		stateValue        types.String
+		expectedPlanValue types.String
+		expectedErrors    int
+	}
+	tests := map[string]testCase{
+		"configured": {
+			configValue:       types.StringValue("config-tenant"),
+			defaultValue:      types.StringValue("default-tenant"),
+			state:             absent,
+			stateValue:        types.StringNull(),
+			expectedPlanValue: types.StringValue("config-tenant"),
+		},
+		"provider default": {
+			configValue:       types.StringNull(),
+			defaultValue:      types.StringValue("default-tenant"),
+			state:             existing,
+			stateValue:        types.StringValue("state-tenant"),
+			expectedPlanValue: types.StringValue("default-tenant"),
+		},
+		"unknown provider default": {
+			configValue:       types.StringNull(),
+			defaultValue:      types.StringUnknown(),
+			required:          true,
+			state:             absent,
+			stateValue:        types.StringNull(),
+			expectedPlanValue: types.StringUnknown(),
+		},
+		"optional without default on create": {
+			configValue:       types.StringNull(),
+			defaultValue:      types.StringValue(""),
+			state:             absent,
+			stateValue:        types.StringNull(),
+			expectedPlanValue: types.StringUnknown(),
+		},
+		"optional without default on update": {
+			configValue:       types.StringNull(),
+			defaultValue:      types.StringNull(),
+			state:             existing,
+			stateValue:        types.StringValue("state-tenant"),
+			expectedPlanValue: types.StringValue("state-tenant"),
+		},
+		"required without default": {
+			configValue:       types.StringNull(),
+			defaultValue:      types.StringValue(""),
+			required:          true,
+			state:             existing,
+			stateValue:        types.StringValue("state-tenant"),
+			expectedPlanValue: types.StringUnknown(),
+			expectedErrors:    1,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			plan := tftypes.NewValue(objectType, map[string]tftypes.Value{
+				"tenant_id": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			})
+			planValue := types.StringUnknown()
+			if !test.configValue.IsNull() {
+				planValue = test.configValue
+			}
+			request := planmodifier.StringRequest{
+				ConfigValue: test.configValue,
+				Path:        path.Root("tenant_id"),
+				Plan:        tfsdk.Plan{Raw: plan},
+				PlanValue:   planValue,
+				State:       tfsdk.State{Raw: test.state},
+				StateValue:  test.stateValue,
+			}
+			response := &planmodifier.StringResponse{PlanValue: request.PlanValue}
+
+			modifier := ProviderDefault("default_tenant_id", func() types.String { return test.defaultValue }, test.required)
+			modifier.PlanModifyString(context.Background(), request, response)
+
+			assert.Equal(t, test.expectedPlanValue, response.PlanValue)
+			assert.Len(t, response.Diagnostics, test.expectedErrors)
+		})
+	}
+}
@@ -0,0 +1,182 @@
+package helper
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type readCacheContextKey struct{}
+
+// WithReadCache marks the requests sent with the returned context as
+// cacheable by ReadCacheTransport. It is meant for reads of parent objects,
+// e.g. the firewall of a forwarding rule, which are shared by many resources.
+func WithReadCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, readCacheContextKey{}, true)
+}
+
+// ReadCacheTransport is a http.RoundTripper caching successful GET requests
+// marked with WithReadCache for a short time. Concurrent requests for the same
+// URL are coalesced into a single request.
+//
+// Requests with other methods invalidate all cached URLs whose path is a
+// parent or child of the request path, e.g. a POST to
+// "firewalls/1/forwarding-rules" invalidates "firewalls/1".
+type ReadCacheTransport struct {
+	base http.RoundTripper
+	ttl  time.Duration
+
+	mu      sync.Mutex
+	entries map[string]*readCacheEntry
+}
+
+type readCacheEntry struct {
+	done    chan struct{}
+	expires time.Time
+	path    string
+
+	// set once done is closed
+	body     []byte
+	err      error
+	response *http.Response
+}
+
+// NewReadCacheTransport returns a ReadCacheTransport sending requests with
+// base, http.DefaultTransport if nil, and caching responses for ttl.
+func NewReadCacheTransport(base http.RoundTripper, ttl time.Duration) *ReadCacheTransport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	return &ReadCacheTransport{
+		base:    base,
+		entries: make(map[string]*readCacheEntry),
+		ttl:     ttl,
+	}
+}
+
+func (t *ReadCacheTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	ctx := request.Context()
+
+	switch request.Method {
+	case http.MethodGet:
+	case http.MethodHead:
+		return t.base.RoundTrip(request)
+	default:
+		response, err := t.base.RoundTrip(request)
+		// invalidate after the write completed, so no read started before
+		// can be cached with outdated data
+		t.invalidate(request.URL.Path)
+		return response, err
+	}
+	if cacheable, _ := ctx.Value(readCacheContextKey{}).(bool); !cacheable || t.ttl <= 0 {
+		return t.base.RoundTrip(request)
+	}
+
+	key := request.URL.String()
+
+	t.mu.Lock()
+	entry, ok := t.entries[key]
+	if ok && !entry.expires.IsZero() && time.Now().After(entry.expires) {
+		delete(t.entries, key)
+		ok = false
+	}
+	if !ok {
+		entry = &readCacheEntry{done: make(chan struct{}), path: request.URL.Path}
+		t.entries[key] = entry
+	}
+	t.mu.Unlock()
+
+	if ok {
+		select {
+		case <-entry.done:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		// the request was sent with the context of another caller, which
+		// must not fail this one
+		if isContextError(entry.err) && ctx.Err() == nil {
+			tflog.Debug(ctx, "Retrying Xelon API request canceled for another caller", map[string]any{"url": key})
+			return t.RoundTrip(request)
+		}
+		tflog.Debug(ctx, "Using cached Xelon API response", map[string]any{"url": key})
+		return entry.copyResponse(request)
+	}
+
+	t.fetch(key, entry, request)
+
+	return entry.copyResponse(request)
+}
+
+// fetch sends the request for entry. Successful responses are kept for the
+// TTL, other entries are removed before the waiting callers are released.
+func (t *ReadCacheTransport) fetch(key string, entry *readCacheEntry, request *http.Request) {
+	defer close(entry.done)
+
+	response, err := t.base.RoundTrip(request)
+	if err != nil {
+		entry.err = err
+	} else {
+		entry.body, entry.err = io.ReadAll(response.Body)
+		entry.response = response
+		_ = response.Body.Close()
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.entries[key] == entry {
+		if entry.err == nil && entry.response.StatusCode >= 200 && entry.response.StatusCode < 300 {
+			entry.expires = time.Now().Add(t.ttl)
+		} else {
+			delete(t.entries, key)
+		}
+	}
+}
+
+func (t *ReadCacheTransport) invalidate(path string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for key, entry := range t.entries {
+		if isPathPrefix(entry.path, path) || isPathPrefix(path, entry.path) {
+			delete(t.entries, key)
+		}
+	}
+}
+
+// copyResponse returns a copy of the cached response for request, as every
+// caller consumes and closes the response body.
+func (e *readCacheEntry) copyResponse(request *http.Request) (*http.Response, error) {
+	if e.err != nil {
+		return nil, e.err
+	}
+
+	response := *e.response
+	response.Body = io.NopCloser(bytes.NewReader(e.body))
+	response.ContentLength = int64(len(e.body))
+	response.Header = e.response.Header.Clone()
+	response.Request = request
+
+	return &response, nil
+}
+
+// isContextError reports whether err is caused by a canceled context or an
+// exceeded deadline.
+func isContextError(err error) bool {
+	return errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)
+}
+
+// isPathPrefix reports whether prefix is equal to or a parent of path.
+func isPathPrefix(prefix, path string) bool {
+	prefix = strings.TrimSuffix(prefix, "/")
+	path = strings.TrimSuffix(path, "/")
+
+	return path == prefix || strings.HasPrefix(path, prefix+"/")
+}
@@ -0,0 +1,172 @@
+package helper
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestReadCacheServer(t *testing.T, delay time.Duration) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+
+	var reads atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		read := reads.Add(1)
+		time.Sleep(delay)
+		if r.URL.Query().Get("status") == "404" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = fmt.Fprintf(w, "%s %d", r.URL.Path, read)
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &reads
+}
+
+func readCached(t *testing.T, client *http.Client, url string) (int, string) {
+	t.Helper()
+
+	request, err := http.NewRequestWithContext(WithReadCache(context.Background()), http.MethodGet, url, nil)
+	require.NoError(t, err)
+	response, err := client.Do(request)
+	require.NoError(t, err)
+	defer func() { _ = response.Body.Close() }()
+	body, err := io.ReadAll(response.Body)
+	require.NoError(t, err)
+
+	return response.StatusCode, string(body)
+}
+
+func TestReadCacheTransport_CoalescesConcurrentReads(t *testing.T) {
+	server, reads := newTestReadCacheServer(t, 20*time.Millisecond)
+	client := &http.Client{Transport: NewReadCacheTransport(nil, time.Minute)}
+
+	var wg sync.WaitGroup
+	for range 5 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			request, _ := http.NewRequestWithContext(WithReadCache(context.Background()), http.MethodGet, server.URL+"/firewalls/1", nil)
+			response, err := client.Do(request)
+			if assert.NoError(t, err) {
+				body, _ := io.ReadAll(response.Body)
+				_ = response.Body.Close()
+				assert.Equal(t, "/firewalls/1 1", string(body))
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(1), reads.Load())
+}
+
+func TestReadCacheTransport_CanceledLeaderDoesNotFailWaiters(t *testing.T) {
+	server, reads := newTestReadCacheServer(t, 50*time.Millisecond)
+	client := &http.Client{Transport: NewReadCacheTransport(nil, time.Minute)}
+
+	leaderCtx, cancel := context.WithCancel(WithReadCache(context.Background()))
+	leaderDone := make(chan error)
+	go func() {
+		request, _ := http.NewRequestWithContext(leaderCtx, http.MethodGet, server.URL+"/firewalls/1", nil)
+		response, err := client.Do(request)
+		if err == nil {
+			_ = response.Body.Close()
+		}
+		leaderDone <- err
+	}()
+	// let the waiter join the request of the leader before canceling it
+	time.Sleep(10 * time.Millisecond)
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		cancel()
+	}()
+
+	status, body := readCached(t, client, server.URL+"/firewalls/1")
+
+	assert.ErrorIs(t, <-leaderDone, context.Canceled)
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, "/firewalls/1 2", body)
+	assert.Equal(t, int32(2), reads.Load())
+}
+
+func TestReadCacheTransport_TTL(t *testing.T) {
+	server, reads := newTestReadCacheServer(t, 0)
+	client := &http.Client{Transport: NewReadCacheTransport(nil, 50*time.Millisecond)}
+
+	_, body := readCached(t, client, server.URL+"/firewalls/1")
+	assert.Equal(t, "/firewalls/1 1", body)
+	_, body = readCached(t, client, server.URL+"/firewalls/1")
+	assert.Equal(t, "/firewalls/1 1", body)
+
+	time.Sleep(60 * time.Millisecond)
+
+	_, body = readCached(t, client, server.URL+"/firewalls/1")
+	assert.Equal(t, "/firewalls/1 2", body)
+	assert.Equal(t, int32(2), reads.Load())
+}
+
+func TestReadCacheTransport_InvalidatesOnWrite(t *testing.T) {
+	server, reads := newTestReadCacheServer(t, 0)
+	client := &http.Client{Transport: NewReadCacheTransport(nil, time.Minute)}
+
+	readCached(t, client, server.URL+"/firewalls/1")
+	readCached(t, client, server.URL+"/firewalls/2")
+
+	response, err := client.Post(server.URL+"/firewalls/1/forwarding-rules", "application/json", nil)
+	require.NoError(t, err)
+	_ = response.Body.Close()
+
+	_, body := readCached(t, client, server.URL+"/firewalls/1")
+	assert.Equal(t, "/firewalls/1 3", body)
+	_, body = readCached(t, client, server.URL+"/firewalls/2")
+	assert.Equal(t, "/firewalls/2 2", body)
+	assert.Equal(t, int32(3), reads.Load())
+}
+
+func TestReadCacheTransport_NotCached(t *testing.T) {
+	t.Run("without read cache context", func(t *testing.T) {
+		server, reads := newTestReadCacheServer(t, 0)
+		client := &http.Client{Transport: NewReadCacheTransport(nil, time.Minute)}
+
+		for range 2 {
+			response, err := client.Get(server.URL + "/firewalls/1")
+			require.NoError(t, err)
+			_ = response.Body.Close()
+		}
+
+		assert.Equal(t, int32(2), reads.Load())
+	})
+
+	t.Run("unsuccessful response", func(t *testing.T) {
+		server, reads := newTestReadCacheServer(t, 0)
+		client := &http.Client{Transport: NewReadCacheTransport(nil, time.Minute)}
+
+		for range 2 {
+			status, _ := readCached(t, client, server.URL+"/firewalls/1?status=404")
+			assert.Equal(t, http.StatusNotFound, status)
+		}
+
+		assert.Equal(t, int32(2), reads.Load())
+	})
+}
+
+func TestIsPathPrefix(t *testing.T) {
+	assert.True(t, isPathPrefix("/api/v2/firewalls/1", "/api/v2/firewalls/1"))
+	assert.True(t, isPathPrefix("/api/v2/firewalls/1", "/api/v2/firewalls/1/forwarding-rules/"))
+	assert.False(t, isPathPrefix("/api/v2/firewalls/1", "/api/v2/firewalls/10"))
+	assert.False(t, isPathPrefix("/api/v2/firewalls/1/forwarding-rules", "/api/v2/firewalls/1"))
+}
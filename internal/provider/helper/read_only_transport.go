@@ -0,0 +1,31 @@
+package helper
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// ReadOnlyTransport is a http.RoundTripper rejecting all requests other than
+// GET and HEAD before they are sent, so that no request can modify objects
+// in Xelon HQ.
+type ReadOnlyTransport struct {
+	// Base is the transport sending the requests, http.DefaultTransport if nil.
+	Base http.RoundTripper
+}
+
+func (t *ReadOnlyTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	if request.Method != http.MethodGet && request.Method != http.MethodHead {
+		// a round tripper must close the body, even on errors
+		if request.Body != nil {
+			_ = request.Body.Close()
+		}
+		return nil, fmt.Errorf("provider is read-only, refusing %s request to %s", request.Method, request.URL.Path)
+	}
+
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	return base.RoundTrip(request)
+}
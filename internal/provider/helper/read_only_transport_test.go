@@ -0,0 +1,54 @@
+package helper
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadOnlyTransport(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+	client := &http.Client{Transport: &ReadOnlyTransport{}}
+
+	testCases := map[string]struct {
+		method  string
+		wantErr bool
+	}{
+		"GET":    {method: http.MethodGet},
+		"HEAD":   {method: http.MethodHead},
+		"POST":   {method: http.MethodPost, wantErr: true},
+		"PUT":    {method: http.MethodPut, wantErr: true},
+		"PATCH":  {method: http.MethodPatch, wantErr: true},
+		"DELETE": {method: http.MethodDelete, wantErr: true},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			requests.Store(0)
+			request, _ := http.NewRequestWithContext(context.Background(), testCase.method, server.URL+"/devices/123", strings.NewReader("{}"))
+
+			response, err := client.Do(request)
+
+			if testCase.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "provider is read-only, refusing "+testCase.method+" request to /devices/123")
+				assert.Equal(t, int32(0), requests.Load())
+				return
+			}
+			require.NoError(t, err)
+			_ = response.Body.Close()
+			assert.Equal(t, int32(1), requests.Load())
+		})
+	}
+}
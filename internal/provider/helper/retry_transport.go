@@ -0,0 +1,167 @@
+package helper
+
+import (
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const defaultRetryMinWait = 1 * time.Second
+
+// retryableStatusCodes are the response status codes of throttled or
+// temporarily unavailable Xelon API requests.
+var retryableStatusCodes = []int{
+	http.StatusTooManyRequests,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+// RetryTransport is a http.RoundTripper retrying throttled and transient
+// failing requests with exponential backoff and jitter.
+//
+// Requests with idempotent methods are retried on network errors and on the
+// status codes 429, 502, 503, and 504. Requests with other methods are only
+// retried on 429, as throttled requests are rejected before being processed.
+type RetryTransport struct {
+	// Base is the transport sending the requests, http.DefaultTransport if nil.
+	Base http.RoundTripper
+	// MaxRetries is the maximum number of retries per request, 0 disables retries.
+	MaxRetries int
+	// MaxWait is the maximum time to wait before a retry, also limiting the
+	// time requested by the Retry-After header.
+	MaxWait time.Duration
+	// MinWait is the time to wait before the first retry, doubled with every
+	// further retry. Defaults to one second.
+	MinWait time.Duration
+}
+
+func (t *RetryTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	ctx := request.Context()
+
+	for attempt := 0; ; attempt++ {
+		attemptRequest := request
+		if attempt > 0 && request.Body != nil && request.Body != http.NoBody {
+			body, err := request.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			attemptRequest = request.Clone(ctx)
+			attemptRequest.Body = body
+		}
+
+		response, err := t.base().RoundTrip(attemptRequest)
+		if attempt >= t.MaxRetries || !t.retryable(request, response, err) {
+			return response, err
+		}
+
+		wait := t.backoff(attempt, response)
+		fields := map[string]any{
+			"attempt": attempt + 1,
+			"method":  request.Method,
+			"url":     request.URL.String(),
+			"wait":    wait.String(),
+		}
+		if err != nil {
+			fields["error"] = err.Error()
+		}
+		if response != nil {
+			fields["status_code"] = response.StatusCode
+			// drain the body to allow reusing the connection
+			_, _ = io.Copy(io.Discard, response.Body)
+			_ = response.Body.Close()
+		}
+		tflog.Debug(ctx, "Retrying Xelon API request", fields)
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func (t *RetryTransport) base() http.RoundTripper {
+	if t.Base == nil {
+		return http.DefaultTransport
+	}
+	return t.Base
+}
+
+func (t *RetryTransport) retryable(request *http.Request, response *http.Response, err error) bool {
+	if request.Context().Err() != nil {
+		return false
+	}
+	// the request body cannot be sent again
+	if request.Body != nil && request.Body != http.NoBody && request.GetBody == nil {
+		return false
+	}
+
+	if err != nil {
+		return isIdempotentMethod(request.Method)
+	}
+	if response.StatusCode == http.StatusTooManyRequests {
+		return true
+	}
+	return isIdempotentMethod(request.Method) && slices.Contains(retryableStatusCodes, response.StatusCode)
+}
+
+// backoff returns the time to wait before the next attempt. The Retry-After
+// header takes precedence over the exponential backoff.
+func (t *RetryTransport) backoff(attempt int, response *http.Response) time.Duration {
+	if response != nil {
+		if retryAfter, ok := parseRetryAfter(response.Header.Get("Retry-After"), time.Now()); ok {
+			return min(retryAfter, t.MaxWait)
+		}
+	}
+
+	minWait := t.MinWait
+	if minWait <= 0 {
+		minWait = defaultRetryMinWait
+	}
+	wait := t.MaxWait
+	if attempt < 32 {
+		wait = min(minWait<<attempt, t.MaxWait)
+	}
+	if wait <= 0 {
+		return 0
+	}
+
+	// equal jitter: wait at least half of the backoff
+	return wait/2 + rand.N(wait/2+1)
+}
+
+// parseRetryAfter parses the value of a Retry-After header, given either in
+// seconds or as HTTP date.
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return max(date.Sub(now), 0), true
+	}
+
+	return 0, false
+}
+
+func isIdempotentMethod(method string) bool {
+	switch method {
+	case http.MethodDelete, http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodTrace:
+		return true
+	default:
+		return false
+	}
+}
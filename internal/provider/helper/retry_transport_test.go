@@ -0,0 +1,152 @@
+package helper
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestRetryServer(t *testing.T, statusCodes ...int) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempt := int(attempts.Add(1))
+		body, _ := io.ReadAll(r.Body)
+		if attempt <= len(statusCodes) {
+			w.WriteHeader(statusCodes[attempt-1])
+			return
+		}
+		_, _ = w.Write(body)
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &attempts
+}
+
+func TestRetryTransport_RetriesTransientErrors(t *testing.T) {
+	server, attempts := newTestRetryServer(t, http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable)
+	client := &http.Client{Transport: &RetryTransport{MaxRetries: 3, MaxWait: time.Millisecond, MinWait: time.Millisecond}}
+
+	response, err := client.Get(server.URL)
+
+	require.NoError(t, err)
+	defer func() { _ = response.Body.Close() }()
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	assert.Equal(t, int32(4), attempts.Load())
+}
+
+func TestRetryTransport_MaxRetries(t *testing.T) {
+	server, attempts := newTestRetryServer(t, http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusServiceUnavailable)
+	client := &http.Client{Transport: &RetryTransport{MaxRetries: 2, MaxWait: time.Millisecond, MinWait: time.Millisecond}}
+
+	response, err := client.Get(server.URL)
+
+	require.NoError(t, err)
+	defer func() { _ = response.Body.Close() }()
+	assert.Equal(t, http.StatusServiceUnavailable, response.StatusCode)
+	assert.Equal(t, int32(3), attempts.Load())
+}
+
+func TestRetryTransport_NonRetryableStatus(t *testing.T) {
+	server, attempts := newTestRetryServer(t, http.StatusInternalServerError)
+	client := &http.Client{Transport: &RetryTransport{MaxRetries: 3, MaxWait: time.Millisecond, MinWait: time.Millisecond}}
+
+	response, err := client.Get(server.URL)
+
+	require.NoError(t, err)
+	defer func() { _ = response.Body.Close() }()
+	assert.Equal(t, http.StatusInternalServerError, response.StatusCode)
+	assert.Equal(t, int32(1), attempts.Load())
+}
+
+func TestRetryTransport_NonIdempotentMethod(t *testing.T) {
+	t.Run("not retried on unavailable", func(t *testing.T) {
+		server, attempts := newTestRetryServer(t, http.StatusServiceUnavailable)
+		client := &http.Client{Transport: &RetryTransport{MaxRetries: 3, MaxWait: time.Millisecond, MinWait: time.Millisecond}}
+
+		response, err := client.Post(server.URL, "application/json", strings.NewReader(`{"name":"test"}`))
+
+		require.NoError(t, err)
+		defer func() { _ = response.Body.Close() }()
+		assert.Equal(t, http.StatusServiceUnavailable, response.StatusCode)
+		assert.Equal(t, int32(1), attempts.Load())
+	})
+
+	t.Run("retried on throttling with body", func(t *testing.T) {
+		server, attempts := newTestRetryServer(t, http.StatusTooManyRequests)
+		client := &http.Client{Transport: &RetryTransport{MaxRetries: 3, MaxWait: time.Millisecond, MinWait: time.Millisecond}}
+
+		response, err := client.Post(server.URL, "application/json", strings.NewReader(`{"name":"test"}`))
+
+		require.NoError(t, err)
+		defer func() { _ = response.Body.Close() }()
+		body, _ := io.ReadAll(response.Body)
+		assert.Equal(t, http.StatusOK, response.StatusCode)
+		assert.JSONEq(t, `{"name":"test"}`, string(body))
+		assert.Equal(t, int32(2), attempts.Load())
+	})
+}
+
+func TestRetryTransport_ContextCanceled(t *testing.T) {
+	server, attempts := newTestRetryServer(t, http.StatusServiceUnavailable, http.StatusServiceUnavailable)
+	client := &http.Client{Transport: &RetryTransport{MaxRetries: 3, MaxWait: time.Minute, MinWait: time.Minute}}
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	request, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+
+	_, err := client.Do(request)
+
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Equal(t, int32(1), attempts.Load())
+}
+
+func TestRetryTransport_Backoff(t *testing.T) {
+	transport := &RetryTransport{MaxWait: 10 * time.Second, MinWait: time.Second}
+
+	for attempt, maxWait := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second, 10 * time.Second, 10 * time.Second} {
+		wait := transport.backoff(attempt, nil)
+
+		assert.GreaterOrEqual(t, wait, maxWait/2)
+		assert.LessOrEqual(t, wait, maxWait)
+	}
+
+	response := &http.Response{Header: http.Header{"Retry-After": []string{"3"}}}
+	assert.Equal(t, 3*time.Second, transport.backoff(0, response))
+	response.Header.Set("Retry-After", "60")
+	assert.Equal(t, 10*time.Second, transport.backoff(0, response))
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		value  string
+		want   time.Duration
+		wantOK bool
+	}{
+		"empty":        {value: "", wantOK: false},
+		"seconds":      {value: "120", want: 2 * time.Minute, wantOK: true},
+		"negative":     {value: "-1", wantOK: false},
+		"http date":    {value: "Mon, 01 Jan 2024 12:00:30 GMT", want: 30 * time.Second, wantOK: true},
+		"date in past": {value: "Mon, 01 Jan 2024 11:00:00 GMT", want: 0, wantOK: true},
+		"invalid":      {value: "soon", wantOK: false},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			wait, ok := parseRetryAfter(testCase.value, now)
+
+			assert.Equal(t, testCase.wantOK, ok)
+			assert.Equal(t, testCase.want, wait)
+		})
+	}
+}
@@ -10,11 +10,11 @@ import (
 	"github.com/Xelon-AG/xelon-sdk-go/xelon"
 )
 
-func WaitTemplateStateReady(ctx context.Context, client *xelon.Client, templateID string) error {
+func WaitTemplateStateReady(ctx context.Context, client *xelon.Client, templateID string, timeout time.Duration) error {
 	stateConf := &retry.StateChangeConf{
 		Pending:    []string{templateStateCreating},
 		Target:     []string{templateStateReady},
-		Timeout:    10 * time.Minute,
+		Timeout:    timeout,
 		MinTimeout: 5 * time.Second,
 		Delay:      3 * time.Second,
 		Refresh:    statusTemplateState(ctx, client, templateID),
@@ -0,0 +1,138 @@
+package helper
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// tokenRefreshBefore is the time before expiry at which a new token is
+// requested, so that no request is sent with an expiring token.
+const tokenRefreshBefore = time.Minute
+
+// tokenCommandTimeout is the time after which a token command is killed, so
+// that a hanging credential helper doesn't block all requests.
+const tokenCommandTimeout = 2 * time.Minute
+
+// TokenCommand obtains API tokens from an external credential helper. The
+// command must print a JSON object with the token and an optional RFC 3339
+// expiry time to stdout, e.g. {"token": "...", "expires_at": "..."}.
+//
+// Tokens are cached until shortly before they expire. Tokens without expiry
+// are cached for the lifetime of the provider.
+type TokenCommand struct {
+	args    []string
+	timeout time.Duration
+
+	mu        sync.Mutex
+	expiresAt time.Time
+	token     string
+}
+
+type tokenCommandOutput struct {
+	ExpiresAt *time.Time `json:"expires_at"`
+	Token     string     `json:"token"`
+}
+
+// NewTokenCommand returns a TokenCommand running the program args[0] with
+// the arguments args[1:]. The command is killed after 2 minutes.
+func NewTokenCommand(args []string) *TokenCommand {
+	return &TokenCommand{args: args, timeout: tokenCommandTimeout}
+}
+
+// Token returns the cached token, or runs the command if there is no valid
+// token.
+func (c *TokenCommand) Token(ctx context.Context) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.token != "" && (c.expiresAt.IsZero() || time.Until(c.expiresAt) > tokenRefreshBefore) {
+		return c.token, nil
+	}
+
+	tflog.Debug(ctx, "Running token command", map[string]any{"command": c.args[0]})
+	output, err := c.run(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	c.token = output.Token
+	c.expiresAt = time.Time{}
+	if output.ExpiresAt != nil {
+		c.expiresAt = *output.ExpiresAt
+	}
+	tflog.Debug(ctx, "Got token from token command", map[string]any{"expires_at": c.expiresAt})
+
+	return c.token, nil
+}
+
+func (c *TokenCommand) run(ctx context.Context) (*tokenCommandOutput, error) {
+	if len(c.args) == 0 {
+		return nil, errors.New("token command is empty")
+	}
+
+	runCtx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(runCtx, c.args[0], c.args[1:]...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	// don't wait for child processes still holding stdout or stderr after
+	// the command was killed
+	cmd.WaitDelay = time.Second
+
+	if err := cmd.Run(); err != nil {
+		if errors.Is(runCtx.Err(), context.DeadlineExceeded) && ctx.Err() == nil {
+			return nil, fmt.Errorf("token command %q did not finish within %s", c.args[0], c.timeout)
+		}
+		if message := strings.TrimSpace(stderr.String()); message != "" {
+			return nil, fmt.Errorf("running token command %q: %w: %s", c.args[0], err, message)
+		}
+		return nil, fmt.Errorf("running token command %q: %w", c.args[0], err)
+	}
+
+	var output tokenCommandOutput
+	if err := json.Unmarshal(stdout.Bytes(), &output); err != nil {
+		return nil, fmt.Errorf("parsing output of token command %q: %w", c.args[0], err)
+	}
+	if output.Token == "" {
+		return nil, fmt.Errorf("token command %q returned no token", c.args[0])
+	}
+
+	return &output, nil
+}
+
+// TokenTransport is a http.RoundTripper authorizing requests with the current
+// token of a TokenCommand.
+type TokenTransport struct {
+	Base    http.RoundTripper
+	Command *TokenCommand
+}
+
+func (t *TokenTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	token, err := t.Command.Token(request.Context())
+	if err != nil {
+		return nil, err
+	}
+
+	// a round tripper must not modify the original request
+	request = request.Clone(request.Context())
+	request.Header.Set("Authorization", "Bearer "+token)
+
+	return base.RoundTrip(request)
+}
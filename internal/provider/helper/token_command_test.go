@@ -0,0 +1,145 @@
+package helper
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestTokenCommandHelperProcess is run as token command by the tests below,
+// printing XELON_TEST_TOKEN_OUTPUT after sleeping XELON_TEST_TOKEN_SLEEP and
+// counting its runs in XELON_TEST_TOKEN_RUNS.
+func TestTokenCommandHelperProcess(t *testing.T) {
+	if os.Getenv("XELON_TEST_TOKEN_COMMAND") != "1" {
+		t.Skip("only run as token command")
+	}
+
+	if runs := os.Getenv("XELON_TEST_TOKEN_RUNS"); runs != "" {
+		file, err := os.OpenFile(runs, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+		require.NoError(t, err)
+		_, _ = file.WriteString("run\n")
+		_ = file.Close()
+	}
+	if sleep, err := time.ParseDuration(os.Getenv("XELON_TEST_TOKEN_SLEEP")); err == nil {
+		time.Sleep(sleep)
+	}
+	if message := os.Getenv("XELON_TEST_TOKEN_ERROR"); message != "" {
+		_, _ = fmt.Fprint(os.Stderr, message)
+		os.Exit(2)
+	}
+	_, _ = fmt.Fprint(os.Stdout, os.Getenv("XELON_TEST_TOKEN_OUTPUT"))
+	os.Exit(0)
+}
+
+func newTestTokenCommand(t *testing.T, output string) (*TokenCommand, func() int) {
+	t.Helper()
+
+	runs := filepath.Join(t.TempDir(), "runs")
+	t.Setenv("XELON_TEST_TOKEN_COMMAND", "1")
+	t.Setenv("XELON_TEST_TOKEN_OUTPUT", output)
+	t.Setenv("XELON_TEST_TOKEN_RUNS", runs)
+
+	command := NewTokenCommand([]string{os.Args[0], "-test.run=^TestTokenCommandHelperProcess$"})
+	countRuns := func() int {
+		content, _ := os.ReadFile(runs)
+		return len(content) / len("run\n")
+	}
+
+	return command, countRuns
+}
+
+func TestTokenCommand_CachesToken(t *testing.T) {
+	command, countRuns := newTestTokenCommand(t, `{"token": "secret-token"}`)
+
+	for range 2 {
+		token, err := command.Token(context.Background())
+
+		require.NoError(t, err)
+		assert.Equal(t, "secret-token", token)
+	}
+	assert.Equal(t, 1, countRuns())
+}
+
+func TestTokenCommand_RefreshesBeforeExpiry(t *testing.T) {
+	expiresAt := time.Now().Add(30 * time.Second).UTC().Format(time.RFC3339)
+	command, countRuns := newTestTokenCommand(t, fmt.Sprintf(`{"token": "short-lived", "expires_at": %q}`, expiresAt))
+
+	for range 2 {
+		token, err := command.Token(context.Background())
+
+		require.NoError(t, err)
+		assert.Equal(t, "short-lived", token)
+	}
+	assert.Equal(t, 2, countRuns())
+}
+
+func TestTokenCommand_Errors(t *testing.T) {
+	t.Run("non-zero exit", func(t *testing.T) {
+		command, _ := newTestTokenCommand(t, "")
+		t.Setenv("XELON_TEST_TOKEN_ERROR", "not logged in")
+
+		_, err := command.Token(context.Background())
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "exit status 2: not logged in")
+	})
+
+	t.Run("invalid output", func(t *testing.T) {
+		command, _ := newTestTokenCommand(t, "secret-token")
+
+		_, err := command.Token(context.Background())
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "parsing output of token command")
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		command, _ := newTestTokenCommand(t, `{"token": "secret-token"}`)
+		command.timeout = 100 * time.Millisecond
+		t.Setenv("XELON_TEST_TOKEN_SLEEP", "10s")
+
+		start := time.Now()
+		_, err := command.Token(context.Background())
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "did not finish within 100ms")
+		assert.Less(t, time.Since(start), 5*time.Second)
+	})
+
+	t.Run("missing token", func(t *testing.T) {
+		command, _ := newTestTokenCommand(t, `{"expires_at": "2030-01-01T00:00:00Z"}`)
+
+		_, err := command.Token(context.Background())
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "returned no token")
+	})
+}
+
+func TestTokenTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(r.Header.Get("Authorization")))
+	}))
+	t.Cleanup(server.Close)
+	command, _ := newTestTokenCommand(t, `{"token": "secret-token"}`)
+	client := &http.Client{Transport: &TokenTransport{Command: command}}
+
+	request, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, server.URL, nil)
+	request.Header.Set("Authorization", "Bearer static-token")
+	response, err := client.Do(request)
+
+	require.NoError(t, err)
+	defer func() { _ = response.Body.Close() }()
+	body, _ := io.ReadAll(response.Body)
+	assert.Equal(t, "Bearer secret-token", string(body))
+	assert.Equal(t, "Bearer static-token", request.Header.Get("Authorization"))
+}
@@ -0,0 +1,367 @@
+package helper
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"unicode"
+)
+
+// ZoneFileRecord is a resource record of a DNS zone file in RFC 1035 master
+// file format.
+type ZoneFileRecord struct {
+	// Data holds the RDATA fields. Domain names are fully qualified without
+	// the trailing dot, character strings are unquoted.
+	Data []string
+	// Line is the line number the record starts at, 0 if not parsed.
+	Line int
+	// Name is the owner name relative to the zone origin, "@" for the apex.
+	Name string
+	TTL  int64
+	Type string
+}
+
+// zoneFileDomainNameFields lists the RDATA fields holding domain names, which
+// are subject to origin completion.
+var zoneFileDomainNameFields = map[string][]int{
+	"ALIAS": {0},
+	"CNAME": {0},
+	"DNAME": {0},
+	"MX":    {1},
+	"NS":    {0},
+	"PTR":   {0},
+	"SOA":   {0, 1},
+	"SRV":   {3},
+}
+
+type zoneFileToken struct {
+	quoted bool
+	value  string
+}
+
+type zoneFileLine struct {
+	blankOwner bool
+	number     int
+	tokens     []zoneFileToken
+}
+
+// ParseZoneFile parses content in RFC 1035 master file format for the zone
+// named origin. The $ORIGIN and $TTL directives, comments, parentheses, and
+// quoted character strings are supported, $INCLUDE is not.
+func ParseZoneFile(content, origin string) ([]ZoneFileRecord, error) {
+	zoneOrigin := strings.ToLower(strings.TrimSuffix(origin, "."))
+	if zoneOrigin == "" {
+		return nil, errors.New("zone origin must not be empty")
+	}
+
+	lines, err := tokenizeZoneFile(content)
+	if err != nil {
+		return nil, err
+	}
+
+	currentOrigin := zoneOrigin
+	defaultTTL, lastTTL := int64(-1), int64(-1)
+	owner := ""
+	var records []ZoneFileRecord
+	for _, line := range lines {
+		tokens := line.tokens
+
+		if !tokens[0].quoted && strings.HasPrefix(tokens[0].value, "$") {
+			directive := strings.ToUpper(tokens[0].value)
+			switch directive {
+			case "$ORIGIN":
+				if len(tokens) != 2 {
+					return nil, fmt.Errorf("invalid $ORIGIN directive on line %d", line.number)
+				}
+				currentOrigin = absoluteZoneFileName(tokens[1].value, currentOrigin)
+			case "$TTL":
+				ttl, ok := parseZoneFileTTL(tokens[len(tokens)-1].value)
+				if len(tokens) != 2 || !ok {
+					return nil, fmt.Errorf("invalid $TTL directive on line %d", line.number)
+				}
+				defaultTTL = ttl
+			default:
+				return nil, fmt.Errorf("unsupported directive %s on line %d", directive, line.number)
+			}
+			continue
+		}
+
+		if !line.blankOwner {
+			owner = absoluteZoneFileName(tokens[0].value, currentOrigin)
+			tokens = tokens[1:]
+		} else if owner == "" {
+			return nil, fmt.Errorf("missing owner name on line %d", line.number)
+		}
+
+		// TTL and class are both optional and may appear in any order
+		ttl := int64(-1)
+		for range 2 {
+			if len(tokens) == 0 || tokens[0].quoted {
+				break
+			}
+			if value, ok := parseZoneFileTTL(tokens[0].value); ok && ttl < 0 {
+				ttl = value
+				tokens = tokens[1:]
+				continue
+			}
+			if class := strings.ToUpper(tokens[0].value); slices.Contains([]string{"CH", "CS", "HS", "IN"}, class) {
+				if class != "IN" {
+					return nil, fmt.Errorf("unsupported class %s on line %d", class, line.number)
+				}
+				tokens = tokens[1:]
+				continue
+			}
+			break
+		}
+		if len(tokens) == 0 {
+			return nil, fmt.Errorf("missing record type on line %d", line.number)
+		}
+
+		switch {
+		case ttl >= 0:
+			lastTTL = ttl
+		case defaultTTL >= 0:
+			ttl = defaultTTL
+		case lastTTL >= 0:
+			ttl = lastTTL
+		default:
+			return nil, fmt.Errorf("missing TTL on line %d", line.number)
+		}
+
+		recordType := strings.ToUpper(tokens[0].value)
+		data := make([]string, 0, len(tokens)-1)
+		for _, token := range tokens[1:] {
+			data = append(data, token.value)
+		}
+		for _, index := range zoneFileDomainNameFields[recordType] {
+			if index < len(data) {
+				data[index] = absoluteZoneFileName(data[index], currentOrigin)
+			}
+		}
+
+		name, ok := relativeZoneFileName(owner, zoneOrigin)
+		if !ok {
+			return nil, fmt.Errorf("owner name %s on line %d is outside of zone %s", owner, line.number, zoneOrigin)
+		}
+
+		records = append(records, ZoneFileRecord{
+			Data: data,
+			Line: line.number,
+			Name: name,
+			TTL:  ttl,
+			Type: recordType,
+		})
+	}
+
+	return records, nil
+}
+
+// RenderZoneFile renders records in RFC 1035 master file format for the zone
+// named origin. It is the inverse of ParseZoneFile.
+func RenderZoneFile(origin string, records []ZoneFileRecord) string {
+	var builder strings.Builder
+	fmt.Fprintf(&builder, "$ORIGIN %s.\n", strings.TrimSuffix(origin, "."))
+
+	writer := tabwriter.NewWriter(&builder, 0, 8, 1, ' ', 0)
+	for _, record := range records {
+		fields := make([]string, 0, len(record.Data))
+		for index, value := range record.Data {
+			switch {
+			case slices.Contains(zoneFileDomainNameFields[record.Type], index):
+				fields = append(fields, strings.TrimSuffix(value, ".")+".")
+			case record.Type == "TXT":
+				// a single character string is limited to 255 characters
+				for len(value) > 255 {
+					fields = append(fields, quoteZoneFileString(value[:255]))
+					value = value[255:]
+				}
+				fields = append(fields, quoteZoneFileString(value))
+			case record.Type == "CAA" && index == 2:
+				fields = append(fields, quoteZoneFileString(value))
+			default:
+				fields = append(fields, value)
+			}
+		}
+		_, _ = fmt.Fprintf(writer, "%s\t%d\tIN\t%s\t%s\n", record.Name, record.TTL, record.Type, strings.Join(fields, " "))
+	}
+	_ = writer.Flush()
+
+	return builder.String()
+}
+
+// tokenizeZoneFile splits content into logical lines of tokens. Lines
+// continued with parentheses are joined, comments and empty lines dropped.
+func tokenizeZoneFile(content string) ([]zoneFileLine, error) {
+	var lines []zoneFileLine
+	var current zoneFileLine
+	var token strings.Builder
+	inToken, inQuote := false, false
+	atLineStart := true
+	lineNumber, parenDepth := 1, 0
+
+	flushToken := func(quoted bool) {
+		if inToken {
+			current.tokens = append(current.tokens, zoneFileToken{quoted: quoted, value: token.String()})
+		}
+		token.Reset()
+		inToken = false
+	}
+	flushLine := func() {
+		if len(current.tokens) > 0 {
+			lines = append(lines, current)
+		}
+		current = zoneFileLine{}
+		atLineStart = true
+	}
+
+	runes := []rune(content)
+	for i := 0; i < len(runes); i++ {
+		c := runes[i]
+		if atLineStart {
+			current.blankOwner = c == ' ' || c == '\t'
+			current.number = lineNumber
+			atLineStart = false
+		}
+
+		if inQuote {
+			switch c {
+			case '\n':
+				return nil, fmt.Errorf("unterminated quoted string on line %d", lineNumber)
+			case '\\':
+				if i+3 < len(runes) && isZoneFileDigits(runes[i+1:i+4]) {
+					value, _ := strconv.Atoi(string(runes[i+1 : i+4]))
+					token.WriteByte(byte(value))
+					i += 3
+				} else if i+1 < len(runes) {
+					token.WriteRune(runes[i+1])
+					i++
+				}
+			case '"':
+				inQuote = false
+				flushToken(true)
+			default:
+				token.WriteRune(c)
+			}
+			continue
+		}
+
+		switch {
+		case c == '\n':
+			flushToken(false)
+			lineNumber++
+			if parenDepth == 0 {
+				flushLine()
+			}
+		case c == ';':
+			for i+1 < len(runes) && runes[i+1] != '\n' {
+				i++
+			}
+		case c == '(':
+			flushToken(false)
+			parenDepth++
+		case c == ')':
+			flushToken(false)
+			parenDepth--
+			if parenDepth < 0 {
+				return nil, fmt.Errorf("unbalanced parentheses on line %d", lineNumber)
+			}
+		case c == '"':
+			flushToken(false)
+			inToken, inQuote = true, true
+		case unicode.IsSpace(c):
+			flushToken(false)
+		case c == '\\' && i+1 < len(runes):
+			// keep escapes outside of quotes, e.g. for dots in mailbox names
+			token.WriteRune(c)
+			token.WriteRune(runes[i+1])
+			inToken = true
+			i++
+		default:
+			token.WriteRune(c)
+			inToken = true
+		}
+	}
+
+	if inQuote {
+		return nil, fmt.Errorf("unterminated quoted string on line %d", lineNumber)
+	}
+	if parenDepth != 0 {
+		return nil, fmt.Errorf("unbalanced parentheses on line %d", lineNumber)
+	}
+	flushToken(false)
+	flushLine()
+
+	return lines, nil
+}
+
+// parseZoneFileTTL parses a TTL in seconds, also accepting the BIND unit
+// suffixes (e.g. "1h30m").
+func parseZoneFileTTL(value string) (int64, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if ttl, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return ttl, ttl >= 0
+	}
+
+	units := map[rune]int64{'s': 1, 'm': 60, 'h': 3600, 'd': 86400, 'w': 604800}
+	var ttl, number int64
+	hasNumber := false
+	for _, c := range strings.ToLower(value) {
+		if c >= '0' && c <= '9' {
+			number = number*10 + int64(c-'0')
+			hasNumber = true
+			continue
+		}
+		unit, ok := units[c]
+		if !ok || !hasNumber {
+			return 0, false
+		}
+		ttl += number * unit
+		number, hasNumber = 0, false
+	}
+	if hasNumber {
+		return 0, false
+	}
+
+	return ttl, true
+}
+
+func absoluteZoneFileName(name, origin string) string {
+	name = strings.ToLower(name)
+	switch {
+	case name == "@":
+		return origin
+	case strings.HasSuffix(name, ".") && !strings.HasSuffix(name, `\.`):
+		return strings.TrimSuffix(name, ".")
+	default:
+		return name + "." + origin
+	}
+}
+
+func relativeZoneFileName(name, origin string) (string, bool) {
+	if name == origin {
+		return "@", true
+	}
+	if relativeName, ok := strings.CutSuffix(name, "."+origin); ok {
+		return relativeName, true
+	}
+
+	return "", false
+}
+
+func quoteZoneFileString(value string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(value) + `"`
+}
+
+func isZoneFileDigits(runes []rune) bool {
+	for _, r := range runes {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,167 @@
+package helper
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseZoneFile(t *testing.T) {
+	content := `
+$ORIGIN example.com.
+$TTL 1h
+@        IN SOA ns1.example.com. hostmaster.example.com. (
+                2024010101 ; serial
+                7200       ; refresh
+                1800       ; retry
+                1209600    ; expire
+                3600 )     ; minimum
+         IN NS  ns1.xdns.cloud.
+www  300 IN A   203.0.113.10
+         IN AAAA 2001:db8::10
+api      CNAME  www
+@     IN 600 MX 10 mail
+_sip._tcp SRV 10 5 5060 sip.example.com.
+@        CAA 0 issue "letsencrypt.org"
+@        TXT "v=spf1 mx -all" ; spf policy
+long     TXT "part one " "part \"two\""
+$ORIGIN sub.example.com.
+host     A 203.0.113.20
+`
+
+	records, err := ParseZoneFile(content, "Example.com.")
+
+	require.NoError(t, err)
+	assert.Equal(t, []ZoneFileRecord{
+		{Data: []string{"ns1.example.com", "hostmaster.example.com", "2024010101", "7200", "1800", "1209600", "3600"}, Line: 4, Name: "@", TTL: 3600, Type: "SOA"},
+		{Data: []string{"ns1.xdns.cloud"}, Line: 10, Name: "@", TTL: 3600, Type: "NS"},
+		{Data: []string{"203.0.113.10"}, Line: 11, Name: "www", TTL: 300, Type: "A"},
+		{Data: []string{"2001:db8::10"}, Line: 12, Name: "www", TTL: 3600, Type: "AAAA"},
+		{Data: []string{"www.example.com"}, Line: 13, Name: "api", TTL: 3600, Type: "CNAME"},
+		{Data: []string{"10", "mail.example.com"}, Line: 14, Name: "@", TTL: 600, Type: "MX"},
+		{Data: []string{"10", "5", "5060", "sip.example.com"}, Line: 15, Name: "_sip._tcp", TTL: 3600, Type: "SRV"},
+		{Data: []string{"0", "issue", "letsencrypt.org"}, Line: 16, Name: "@", TTL: 3600, Type: "CAA"},
+		{Data: []string{"v=spf1 mx -all"}, Line: 17, Name: "@", TTL: 3600, Type: "TXT"},
+		{Data: []string{"part one ", `part "two"`}, Line: 18, Name: "long", TTL: 3600, Type: "TXT"},
+		{Data: []string{"203.0.113.20"}, Line: 20, Name: "host.sub", TTL: 3600, Type: "A"},
+	}, records)
+}
+
+func TestParseZoneFile_LastExplicitTTL(t *testing.T) {
+	records, err := ParseZoneFile("www 300 A 203.0.113.10\napi A 203.0.113.20\n", "example.com")
+
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	assert.Equal(t, int64(300), records[1].TTL)
+}
+
+func TestParseZoneFile_Invalid(t *testing.T) {
+	testCases := map[string]struct {
+		content string
+		wantErr string
+	}{
+		"missing ttl": {
+			content: "www A 203.0.113.10",
+			wantErr: "missing TTL on line 1",
+		},
+		"missing owner": {
+			content: "  300 A 203.0.113.10",
+			wantErr: "missing owner name on line 1",
+		},
+		"missing type": {
+			content: "www 300 IN",
+			wantErr: "missing record type on line 1",
+		},
+		"unsupported class": {
+			content: "www 300 CH A 203.0.113.10",
+			wantErr: "unsupported class CH on line 1",
+		},
+		"unsupported directive": {
+			content: "$INCLUDE other.zone",
+			wantErr: "unsupported directive $INCLUDE on line 1",
+		},
+		"outside of zone": {
+			content: "www.example.org. 300 A 203.0.113.10",
+			wantErr: "owner name www.example.org on line 1 is outside of zone example.com",
+		},
+		"unterminated quote": {
+			content: "www 300 TXT \"value\n",
+			wantErr: "unterminated quoted string on line 1",
+		},
+		"unbalanced parentheses": {
+			content: "www 300 TXT ( \"value\"\n",
+			wantErr: "unbalanced parentheses on line 2",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			_, err := ParseZoneFile(testCase.content, "example.com")
+
+			assert.EqualError(t, err, testCase.wantErr)
+		})
+	}
+}
+
+func TestParseZoneFileTTL(t *testing.T) {
+	testCases := map[string]int64{
+		"0":     0,
+		"3600":  3600,
+		"1h":    3600,
+		"1h30m": 5400,
+		"1W2D":  777600,
+	}
+
+	for value, want := range testCases {
+		t.Run(value, func(t *testing.T) {
+			ttl, ok := parseZoneFileTTL(value)
+
+			require.True(t, ok)
+			assert.Equal(t, want, ttl)
+		})
+	}
+
+	for _, value := range []string{"", "-1", "h", "1x", "10m5"} {
+		t.Run(value, func(t *testing.T) {
+			_, ok := parseZoneFileTTL(value)
+
+			assert.False(t, ok)
+		})
+	}
+}
+
+func TestRenderZoneFile(t *testing.T) {
+	records := []ZoneFileRecord{
+		{Data: []string{"ns1.xdns.cloud", `first\.last.example.com`, "1", "7200", "1800", "1209600", "3600"}, Name: "@", TTL: 3600, Type: "SOA"},
+		{Data: []string{"203.0.113.10"}, Name: "www", TTL: 300, Type: "A"},
+		{Data: []string{"10", "mail.example.com."}, Name: "@", TTL: 3600, Type: "MX"},
+		{Data: []string{"0", "issue", "letsencrypt.org"}, Name: "@", TTL: 3600, Type: "CAA"},
+		{Data: []string{`say "hi"`}, Name: "@", TTL: 3600, Type: "TXT"},
+	}
+
+	content := RenderZoneFile("example.com", records)
+
+	assert.Equal(t, `$ORIGIN example.com.
+@   3600 IN SOA ns1.xdns.cloud. first\.last.example.com. 1 7200 1800 1209600 3600
+www 300  IN A   203.0.113.10
+@   3600 IN MX  10 mail.example.com.
+@   3600 IN CAA 0 issue "letsencrypt.org"
+@   3600 IN TXT "say \"hi\""
+`, content)
+}
+
+func TestRenderZoneFile_RoundTrip(t *testing.T) {
+	records := []ZoneFileRecord{
+		{Data: []string{"10", "5", "5060", "sip.example.com"}, Name: "_sip._tcp", TTL: 3600, Type: "SRV"},
+		{Data: []string{strings.Repeat("a", 300)}, Name: "long", TTL: 3600, Type: "TXT"},
+	}
+
+	parsedRecords, err := ParseZoneFile(RenderZoneFile("example.com", records), "example.com")
+
+	require.NoError(t, err)
+	require.Len(t, parsedRecords, 2)
+	assert.Equal(t, records[0].Data, parsedRecords[0].Data)
+	assert.Equal(t, []string{strings.Repeat("a", 255), strings.Repeat("a", 45)}, parsedRecords[1].Data)
+}
@@ -0,0 +1,88 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/Xelon-AG/xelon-sdk-go/xelon"
+)
+
+var (
+	_ list.ListResource              = (*deviceListResource)(nil)
+	_ list.ListResourceWithConfigure = (*deviceListResource)(nil)
+)
+
+// deviceListResource is the device list resource implementation.
+type deviceListResource struct {
+	withListResults
+}
+
+// deviceListResourceModel maps the device list resource config schema data.
+type deviceListResourceModel struct {
+	DisplayName types.String `tfsdk:"display_name"`
+	TenantID    types.String `tfsdk:"tenant_id"`
+}
+
+func NewDeviceListResource() list.ListResource {
+	return &deviceListResource{}
+}
+
+func (r *deviceListResource) Metadata(_ context.Context, _ resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "xelon_device"
+}
+
+func (r *deviceListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = schema.Schema{
+		MarkdownDescription: "The device list resource finds existing Xelon devices with `terraform query`.",
+		Attributes: map[string]schema.Attribute{
+			"display_name": schema.StringAttribute{
+				MarkdownDescription: "List only devices whose display name contains this value, ignoring case.",
+				Optional:            true,
+			},
+			"tenant_id": schema.StringAttribute{
+				MarkdownDescription: "List only devices owned by the tenant with this ID.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *deviceListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	var data deviceListResourceModel
+
+	diags := request.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	tflog.Debug(ctx, "Getting devices", map[string]any{
+		"display_name": data.DisplayName.ValueString(),
+		"tenant_id":    data.TenantID.ValueString(),
+	})
+	var filteredDevices []xelon.Device
+	devices, errf := r.client.Devices.All(ctx, &xelon.ListOptions{PerPage: 100})
+	for device := range devices {
+		if deviceMatchesFilters(&device, data.DisplayName.ValueString(), data.TenantID.ValueString()) {
+			filteredDevices = append(filteredDevices, device)
+		}
+	}
+	if err := errf(); err != nil {
+		stream.Results = list.ListResultsStreamDiagnostics(diag.Diagnostics{
+			diag.NewErrorDiagnostic("Unable to list devices", err.Error()),
+		})
+		return
+	}
+	tflog.Debug(ctx, "Got devices", map[string]any{"device_count": len(filteredDevices)})
+
+	stream.Results = listResults(ctx, r.client, request, filteredDevices, NewDeviceResource, func(device xelon.Device, result *list.ListResult) {
+		result.DisplayName = device.DisplayName
+		result.Diagnostics.Append(result.Identity.Set(ctx, idResourceIdentityModel{ID: types.StringValue(device.ID)})...)
+	})
+}
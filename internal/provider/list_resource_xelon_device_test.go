@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Xelon-AG/xelon-sdk-go/xelon"
+)
+
+func TestListResourceXelonDevice_List(t *testing.T) {
+	server := newFakeXelonServer(t)
+	server.Mutate(func(s *fakeXelonServer) {
+		s.devices["1"] = &xelon.Device{ID: "1", DisplayName: "Web 01", CPUCores: 2, RAM: 4, Tenant: &xelon.Tenant{ID: "tenant-123"}}
+		s.devices["2"] = &xelon.Device{ID: "2", DisplayName: "Web 02", CPUCores: 2, RAM: 4, Tenant: &xelon.Tenant{ID: "tenant-456"}}
+		s.devices["3"] = &xelon.Device{ID: "3", DisplayName: "DB 01", CPUCores: 4, RAM: 8, Tenant: &xelon.Tenant{ID: "tenant-123"}}
+	})
+
+	t.Run("display name and tenant filters", func(t *testing.T) {
+		results := testListResults(t, server, NewDeviceListResource(), NewDeviceResource, map[string]tftypes.Value{
+			"display_name": tftypes.NewValue(tftypes.String, "WEB"),
+			"tenant_id":    tftypes.NewValue(tftypes.String, "tenant-123"),
+		}, 0, true)
+
+		require.Len(t, results, 1)
+		assert.Equal(t, "Web 01", results[0].DisplayName)
+
+		var identity idResourceIdentityModel
+		require.False(t, results[0].Identity.Get(context.Background(), &identity).HasError())
+		assert.Equal(t, "1", identity.ID.ValueString())
+
+		var displayName types.String
+		var cpuCoreCount types.Int64
+		require.False(t, results[0].Resource.GetAttribute(context.Background(), path.Root("display_name"), &displayName).HasError())
+		require.False(t, results[0].Resource.GetAttribute(context.Background(), path.Root("cpu_core_count"), &cpuCoreCount).HasError())
+		assert.Equal(t, "Web 01", displayName.ValueString())
+		assert.Equal(t, int64(2), cpuCoreCount.ValueInt64())
+	})
+
+	t.Run("limit", func(t *testing.T) {
+		results := testListResults(t, server, NewDeviceListResource(), NewDeviceResource, nil, 2, false)
+
+		require.Len(t, results, 2)
+		for i, expectedID := range []string{"1", "2"} {
+			var identity idResourceIdentityModel
+			require.False(t, results[i].Identity.Get(context.Background(), &identity).HasError())
+			assert.Equal(t, expectedID, identity.ID.ValueString())
+			// the resource is only read if requested
+			assert.True(t, results[i].Resource.Raw.IsNull())
+		}
+	})
+}
@@ -0,0 +1,97 @@
+package provider
+
+import (
+	"context"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/Xelon-AG/xelon-sdk-go/xelon"
+)
+
+var (
+	_ list.ListResource              = (*dnsRecordListResource)(nil)
+	_ list.ListResourceWithConfigure = (*dnsRecordListResource)(nil)
+)
+
+// dnsRecordListResource is the DNS record list resource implementation.
+type dnsRecordListResource struct {
+	withListResults
+}
+
+// dnsRecordListResourceModel maps the DNS record list resource config schema data.
+type dnsRecordListResourceModel struct {
+	Name   types.String `tfsdk:"name"`
+	ZoneID types.String `tfsdk:"zone_id"`
+}
+
+func NewDNSRecordListResource() list.ListResource {
+	return &dnsRecordListResource{}
+}
+
+func (r *dnsRecordListResource) Metadata(_ context.Context, _ resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "xelon_dns_record"
+}
+
+func (r *dnsRecordListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = schema.Schema{
+		MarkdownDescription: "The DNS record list resource finds existing records of a Xelon DNS zone with `terraform query`. The SOA record, the NS records of the zone apex and record types not supported by `xelon_dns_record` are not listed.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "List only DNS records whose host contains this value, ignoring case.",
+				Optional:            true,
+			},
+			"zone_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the DNS zone to list records from.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *dnsRecordListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	var data dnsRecordListResourceModel
+
+	diags := request.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	zoneID := data.ZoneID.ValueString()
+	tflog.Debug(ctx, "Getting DNS records", map[string]any{
+		"name":    data.Name.ValueString(),
+		"zone_id": zoneID,
+	})
+	records, _, err := r.client.Domains.ListRecords(ctx, zoneID)
+	if err != nil {
+		stream.Results = list.ListResultsStreamDiagnostics(diag.Diagnostics{
+			diag.NewErrorDiagnostic("Unable to list DNS records", err.Error()),
+		})
+		return
+	}
+	// the SOA record, the NS records of the zone apex and unsupported record
+	// types can't be managed by xelon_dns_record
+	records = slices.DeleteFunc(filterManagedDNSRecords(records), func(record xelon.DNSRecord) bool {
+		return !listFilterMatches(record.Host, data.Name)
+	})
+	tflog.Debug(ctx, "Got DNS records", map[string]any{"dns_record_count": len(records)})
+
+	stream.Results = listResults(ctx, r.client, request, records, NewDNSRecordResource, func(record xelon.DNSRecord, result *list.ListResult) {
+		result.DisplayName = record.Host + " " + string(record.Type)
+		result.Diagnostics.Append(result.Identity.Set(ctx, dnsRecordResourceIdentityModel{
+			RecordID: types.Int64Value(int64(record.ID)),
+			ZoneID:   types.StringValue(zoneID),
+		})...)
+	})
+}
@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Xelon-AG/xelon-sdk-go/xelon"
+)
+
+func TestListResourceXelonDNSRecord_List(t *testing.T) {
+	server := newFakeXelonServer(t)
+	server.Mutate(func(s *fakeXelonServer) {
+		s.dnsZones["1"] = &xelon.DNSZone{ID: "1", Name: "example.com"}
+		s.dnsRecords["1"] = []xelon.DNSRecord{
+			{ID: 100, Host: "@", Type: xelon.DNSRecordTypeNS, Record: "ns1.xelon.ch", TTL: 3600},
+			{ID: 101, Host: "@", Type: xelon.DNSRecordType("SOA"), Record: "ns1.xelon.ch hostmaster.xelon.ch 1 3600 600 604800 3600", TTL: 3600},
+			{ID: 102, Host: "@", Type: xelon.DNSRecordTypeA, Record: "203.0.113.10", TTL: 3600},
+			{ID: 103, Host: "www", Type: xelon.DNSRecordTypeA, Record: "203.0.113.20", TTL: 300},
+			{ID: 104, Host: "www", Type: xelon.DNSRecordType("SSHFP"), Record: "1 1 123456789abcdef67890123456789abcdef67890", TTL: 3600},
+		}
+	})
+
+	t.Run("excludes apex NS, SOA and unsupported records", func(t *testing.T) {
+		results := testListResults(t, server, NewDNSRecordListResource(), NewDNSRecordResource, map[string]tftypes.Value{
+			"zone_id": tftypes.NewValue(tftypes.String, "1"),
+		}, 0, false)
+
+		var recordIDs []int64
+		for _, result := range results {
+			var identity dnsRecordResourceIdentityModel
+			require.False(t, result.Identity.Get(context.Background(), &identity).HasError())
+			assert.Equal(t, "1", identity.ZoneID.ValueString())
+			recordIDs = append(recordIDs, identity.RecordID.ValueInt64())
+		}
+		assert.Equal(t, []int64{102, 103}, recordIDs)
+	})
+
+	t.Run("name filter", func(t *testing.T) {
+		results := testListResults(t, server, NewDNSRecordListResource(), NewDNSRecordResource, map[string]tftypes.Value{
+			"name":    tftypes.NewValue(tftypes.String, "WWW"),
+			"zone_id": tftypes.NewValue(tftypes.String, "1"),
+		}, 0, true)
+
+		require.Len(t, results, 1)
+		assert.Equal(t, "www A", results[0].DisplayName)
+
+		var content types.String
+		var ttl types.Int64
+		require.False(t, results[0].Resource.GetAttribute(context.Background(), path.Root("content"), &content).HasError())
+		require.False(t, results[0].Resource.GetAttribute(context.Background(), path.Root("ttl"), &ttl).HasError())
+		assert.Equal(t, "203.0.113.20", content.ValueString())
+		assert.Equal(t, int64(300), ttl.ValueInt64())
+	})
+
+	t.Run("limit", func(t *testing.T) {
+		results := testListResults(t, server, NewDNSRecordListResource(), NewDNSRecordResource, map[string]tftypes.Value{
+			"zone_id": tftypes.NewValue(tftypes.String, "1"),
+		}, 1, false)
+
+		require.Len(t, results, 1)
+		assert.Equal(t, "@ A", results[0].DisplayName)
+	})
+}
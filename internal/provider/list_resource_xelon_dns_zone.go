@@ -0,0 +1,80 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/Xelon-AG/xelon-sdk-go/xelon"
+)
+
+var (
+	_ list.ListResource              = (*dnsZoneListResource)(nil)
+	_ list.ListResourceWithConfigure = (*dnsZoneListResource)(nil)
+)
+
+// dnsZoneListResource is the DNS zone list resource implementation.
+type dnsZoneListResource struct {
+	withListResults
+}
+
+// dnsZoneListResourceModel maps the DNS zone list resource config schema data.
+type dnsZoneListResourceModel struct {
+	Name types.String `tfsdk:"name"`
+}
+
+func NewDNSZoneListResource() list.ListResource {
+	return &dnsZoneListResource{}
+}
+
+func (r *dnsZoneListResource) Metadata(_ context.Context, _ resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "xelon_dns_zone"
+}
+
+func (r *dnsZoneListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = schema.Schema{
+		MarkdownDescription: "The DNS zone list resource finds existing Xelon DNS zones with `terraform query`.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "List only DNS zones whose name contains this value, ignoring case.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *dnsZoneListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	var data dnsZoneListResourceModel
+
+	diags := request.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	tflog.Debug(ctx, "Getting DNS zones", map[string]any{"name": data.Name.ValueString()})
+	var filteredZones []xelon.DNSZone
+	zones, errf := r.client.Domains.AllZones(ctx, &xelon.ListOptions{PerPage: 100})
+	for zone := range zones {
+		if listFilterMatches(zone.Name, data.Name) {
+			filteredZones = append(filteredZones, zone)
+		}
+	}
+	if err := errf(); err != nil {
+		stream.Results = list.ListResultsStreamDiagnostics(diag.Diagnostics{
+			diag.NewErrorDiagnostic("Unable to list DNS zones", err.Error()),
+		})
+		return
+	}
+	tflog.Debug(ctx, "Got DNS zones", map[string]any{"dns_zone_count": len(filteredZones)})
+
+	stream.Results = listResults(ctx, r.client, request, filteredZones, NewDNSZoneResource, func(zone xelon.DNSZone, result *list.ListResult) {
+		result.DisplayName = zone.Name
+		result.Diagnostics.Append(result.Identity.Set(ctx, idResourceIdentityModel{ID: types.StringValue(zone.ID)})...)
+	})
+}
@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"context"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/Xelon-AG/xelon-sdk-go/xelon"
+)
+
+var (
+	_ list.ListResource              = (*firewallListResource)(nil)
+	_ list.ListResourceWithConfigure = (*firewallListResource)(nil)
+)
+
+// firewallListResource is the firewall list resource implementation.
+type firewallListResource struct {
+	withListResults
+}
+
+// firewallListResourceModel maps the firewall list resource config schema data.
+type firewallListResourceModel struct {
+	Name     types.String `tfsdk:"name"`
+	TenantID types.String `tfsdk:"tenant_id"`
+}
+
+func NewFirewallListResource() list.ListResource {
+	return &firewallListResource{}
+}
+
+func (r *firewallListResource) Metadata(_ context.Context, _ resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "xelon_firewall"
+}
+
+func (r *firewallListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = schema.Schema{
+		MarkdownDescription: "The firewall list resource finds existing Xelon firewalls with `terraform query`.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "List only firewalls whose name contains this value, ignoring case.",
+				Optional:            true,
+			},
+			"tenant_id": schema.StringAttribute{
+				MarkdownDescription: "List only firewalls owned by the tenant with this ID.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *firewallListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	var data firewallListResourceModel
+
+	diags := request.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	tflog.Debug(ctx, "Getting firewalls", map[string]any{
+		"name":      data.Name.ValueString(),
+		"tenant_id": data.TenantID.ValueString(),
+	})
+	firewalls, _, err := r.client.Firewalls.List(ctx, nil)
+	if err != nil {
+		stream.Results = list.ListResultsStreamDiagnostics(diag.Diagnostics{
+			diag.NewErrorDiagnostic("Unable to list firewalls", err.Error()),
+		})
+		return
+	}
+	firewalls = slices.DeleteFunc(firewalls, func(firewall xelon.Firewall) bool {
+		return !listFilterMatches(firewall.Name, data.Name) || !tenantFilterMatches(firewall.Tenant, data.TenantID)
+	})
+	tflog.Debug(ctx, "Got firewalls", map[string]any{"firewall_count": len(firewalls)})
+
+	stream.Results = listResults(ctx, r.client, request, firewalls, NewFirewallResource, func(firewall xelon.Firewall, result *list.ListResult) {
+		result.DisplayName = firewall.Name
+		result.Diagnostics.Append(result.Identity.Set(ctx, idResourceIdentityModel{ID: types.StringValue(firewall.ID)})...)
+	})
+}
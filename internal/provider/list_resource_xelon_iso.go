@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"context"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/Xelon-AG/xelon-sdk-go/xelon"
+)
+
+var (
+	_ list.ListResource              = (*isoListResource)(nil)
+	_ list.ListResourceWithConfigure = (*isoListResource)(nil)
+)
+
+// isoListResource is the ISO list resource implementation.
+type isoListResource struct {
+	withListResults
+}
+
+// isoListResourceModel maps the ISO list resource config schema data.
+type isoListResourceModel struct {
+	Name types.String `tfsdk:"name"`
+}
+
+func NewISOListResource() list.ListResource {
+	return &isoListResource{}
+}
+
+func (r *isoListResource) Metadata(_ context.Context, _ resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "xelon_iso"
+}
+
+func (r *isoListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = schema.Schema{
+		MarkdownDescription: "The ISO list resource finds existing Xelon ISOs with `terraform query`.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "List only ISOs whose name contains this value, ignoring case.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *isoListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	var data isoListResourceModel
+
+	diags := request.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	tflog.Debug(ctx, "Getting ISOs", map[string]any{"name": data.Name.ValueString()})
+	isos, _, err := r.client.ISOs.List(ctx, &xelon.ISOListOptions{Search: data.Name.ValueString()})
+	if err != nil {
+		stream.Results = list.ListResultsStreamDiagnostics(diag.Diagnostics{
+			diag.NewErrorDiagnostic("Unable to list ISOs", err.Error()),
+		})
+		return
+	}
+	isos = slices.DeleteFunc(isos, func(iso xelon.ISO) bool {
+		return !listFilterMatches(iso.Name, data.Name)
+	})
+	tflog.Debug(ctx, "Got ISOs", map[string]any{"iso_count": len(isos)})
+
+	stream.Results = listResults(ctx, r.client, request, isos, NewISOResource, func(iso xelon.ISO, result *list.ListResult) {
+		result.DisplayName = iso.Name
+		result.Diagnostics.Append(result.Identity.Set(ctx, idResourceIdentityModel{ID: types.StringValue(iso.ID)})...)
+	})
+}
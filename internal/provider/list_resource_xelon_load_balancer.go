@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"context"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/Xelon-AG/xelon-sdk-go/xelon"
+)
+
+var (
+	_ list.ListResource              = (*loadBalancerListResource)(nil)
+	_ list.ListResourceWithConfigure = (*loadBalancerListResource)(nil)
+)
+
+// loadBalancerListResource is the load balancer list resource implementation.
+type loadBalancerListResource struct {
+	withListResults
+}
+
+// loadBalancerListResourceModel maps the load balancer list resource config schema data.
+type loadBalancerListResourceModel struct {
+	Name     types.String `tfsdk:"name"`
+	TenantID types.String `tfsdk:"tenant_id"`
+}
+
+func NewLoadBalancerListResource() list.ListResource {
+	return &loadBalancerListResource{}
+}
+
+func (r *loadBalancerListResource) Metadata(_ context.Context, _ resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "xelon_load_balancer"
+}
+
+func (r *loadBalancerListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = schema.Schema{
+		MarkdownDescription: "The load balancer list resource finds existing Xelon load balancers with `terraform query`.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "List only load balancers whose name contains this value, ignoring case.",
+				Optional:            true,
+			},
+			"tenant_id": schema.StringAttribute{
+				MarkdownDescription: "List only load balancers owned by the tenant with this ID.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *loadBalancerListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	var data loadBalancerListResourceModel
+
+	diags := request.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	tflog.Debug(ctx, "Getting load balancers", map[string]any{
+		"name":      data.Name.ValueString(),
+		"tenant_id": data.TenantID.ValueString(),
+	})
+	loadBalancers, _, err := r.client.LoadBalancers.List(ctx, &xelon.LoadBalancerListOptions{Search: data.Name.ValueString()})
+	if err != nil {
+		stream.Results = list.ListResultsStreamDiagnostics(diag.Diagnostics{
+			diag.NewErrorDiagnostic("Unable to list load balancers", err.Error()),
+		})
+		return
+	}
+	loadBalancers = slices.DeleteFunc(loadBalancers, func(loadBalancer xelon.LoadBalancer) bool {
+		return !listFilterMatches(loadBalancer.Name, data.Name) || !tenantFilterMatches(loadBalancer.Tenant, data.TenantID)
+	})
+	tflog.Debug(ctx, "Got load balancers", map[string]any{"load_balancer_count": len(loadBalancers)})
+
+	stream.Results = listResults(ctx, r.client, request, loadBalancers, NewLoadBalancerResource, func(loadBalancer xelon.LoadBalancer, result *list.ListResult) {
+		result.DisplayName = loadBalancer.Name
+		result.Diagnostics.Append(result.Identity.Set(ctx, idResourceIdentityModel{ID: types.StringValue(loadBalancer.ID)})...)
+	})
+}
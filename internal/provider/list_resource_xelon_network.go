@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"context"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/Xelon-AG/xelon-sdk-go/xelon"
+)
+
+var (
+	_ list.ListResource              = (*networkListResource)(nil)
+	_ list.ListResourceWithConfigure = (*networkListResource)(nil)
+)
+
+// networkListResource is the network list resource implementation.
+type networkListResource struct {
+	withListResults
+}
+
+// networkListResourceModel maps the network list resource config schema data.
+type networkListResourceModel struct {
+	Name     types.String `tfsdk:"name"`
+	TenantID types.String `tfsdk:"tenant_id"`
+}
+
+func NewNetworkListResource() list.ListResource {
+	return &networkListResource{}
+}
+
+func (r *networkListResource) Metadata(_ context.Context, _ resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "xelon_network"
+}
+
+func (r *networkListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = schema.Schema{
+		MarkdownDescription: "The network list resource finds existing Xelon networks with `terraform query`.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "List only networks whose name contains this value, ignoring case.",
+				Optional:            true,
+			},
+			"tenant_id": schema.StringAttribute{
+				MarkdownDescription: "List only networks owned by the tenant with this ID.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *networkListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	var data networkListResourceModel
+
+	diags := request.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	tflog.Debug(ctx, "Getting networks", map[string]any{
+		"name":      data.Name.ValueString(),
+		"tenant_id": data.TenantID.ValueString(),
+	})
+	networks, _, err := r.client.Networks.List(ctx, &xelon.NetworkListOptions{Search: data.Name.ValueString()})
+	if err != nil {
+		stream.Results = list.ListResultsStreamDiagnostics(diag.Diagnostics{
+			diag.NewErrorDiagnostic("Unable to list networks", err.Error()),
+		})
+		return
+	}
+	networks = slices.DeleteFunc(networks, func(network xelon.Network) bool {
+		return !listFilterMatches(network.Name, data.Name) || !tenantFilterMatches(network.Owner, data.TenantID)
+	})
+	tflog.Debug(ctx, "Got networks", map[string]any{"network_count": len(networks)})
+
+	stream.Results = listResults(ctx, r.client, request, networks, NewNetworkResource, func(network xelon.Network, result *list.ListResult) {
+		result.DisplayName = network.Name
+		result.Diagnostics.Append(result.Identity.Set(ctx, idResourceIdentityModel{ID: types.StringValue(network.ID)})...)
+	})
+}
@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/Xelon-AG/xelon-sdk-go/xelon"
+)
+
+var (
+	_ list.ListResource              = (*objectStorageBucketListResource)(nil)
+	_ list.ListResourceWithConfigure = (*objectStorageBucketListResource)(nil)
+)
+
+// objectStorageBucketListResource is the object storage bucket list resource implementation.
+type objectStorageBucketListResource struct {
+	withListResults
+}
+
+// objectStorageBucketListResourceModel maps the object storage bucket list resource config schema data.
+type objectStorageBucketListResourceModel struct {
+	Name                types.String `tfsdk:"name"`
+	ObjectStorageUserID types.String `tfsdk:"user_id"`
+	TenantID            types.String `tfsdk:"tenant_id"`
+}
+
+func NewObjectStorageBucketListResource() list.ListResource {
+	return &objectStorageBucketListResource{}
+}
+
+func (r *objectStorageBucketListResource) Metadata(_ context.Context, _ resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "xelon_object_storage_bucket"
+}
+
+func (r *objectStorageBucketListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = schema.Schema{
+		MarkdownDescription: "The object storage bucket list resource finds existing Xelon object storage buckets with `terraform query`.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "List only buckets whose name contains this value, ignoring case.",
+				Optional:            true,
+			},
+			"tenant_id": schema.StringAttribute{
+				MarkdownDescription: "List only buckets owned by the tenant with this ID.",
+				Optional:            true,
+			},
+			"user_id": schema.StringAttribute{
+				MarkdownDescription: "List only buckets owned by the object storage user with this ID.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *objectStorageBucketListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	var data objectStorageBucketListResourceModel
+
+	diags := request.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	tflog.Debug(ctx, "Getting object storage buckets", map[string]any{
+		"name":      data.Name.ValueString(),
+		"tenant_id": data.TenantID.ValueString(),
+		"user_id":   data.ObjectStorageUserID.ValueString(),
+	})
+	var filteredBuckets []xelon.ObjectStorageBucket
+	buckets, errf := r.client.ObjectStorages.AllBuckets(ctx, &xelon.ListOptions{PerPage: 100})
+	for bucket := range buckets {
+		if !listFilterMatches(bucket.Name, data.Name) || !tenantFilterMatches(bucket.Tenant, data.TenantID) {
+			continue
+		}
+		if userID := data.ObjectStorageUserID.ValueString(); userID != "" && bucket.ObjectStorageUserID != userID {
+			continue
+		}
+		filteredBuckets = append(filteredBuckets, bucket)
+	}
+	if err := errf(); err != nil {
+		stream.Results = list.ListResultsStreamDiagnostics(diag.Diagnostics{
+			diag.NewErrorDiagnostic("Unable to list object storage buckets", err.Error()),
+		})
+		return
+	}
+	tflog.Debug(ctx, "Got object storage buckets", map[string]any{"object_storage_bucket_count": len(filteredBuckets)})
+
+	stream.Results = listResults(ctx, r.client, request, filteredBuckets, NewObjectStorageBucketResource, func(bucket xelon.ObjectStorageBucket, result *list.ListResult) {
+		result.DisplayName = bucket.Name
+		result.Diagnostics.Append(result.Identity.Set(ctx, objectStorageBucketResourceIdentityModel{
+			Name:                types.StringValue(bucket.Name),
+			ObjectStorageUserID: types.StringValue(bucket.ObjectStorageUserID),
+		})...)
+	})
+}
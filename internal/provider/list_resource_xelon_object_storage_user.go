@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/Xelon-AG/xelon-sdk-go/xelon"
+)
+
+var (
+	_ list.ListResource              = (*objectStorageUserListResource)(nil)
+	_ list.ListResourceWithConfigure = (*objectStorageUserListResource)(nil)
+)
+
+// objectStorageUserListResource is the object storage user list resource implementation.
+type objectStorageUserListResource struct {
+	withListResults
+}
+
+// objectStorageUserListResourceModel maps the object storage user list resource config schema data.
+type objectStorageUserListResourceModel struct {
+	Name     types.String `tfsdk:"name"`
+	Region   types.String `tfsdk:"region"`
+	TenantID types.String `tfsdk:"tenant_id"`
+}
+
+func NewObjectStorageUserListResource() list.ListResource {
+	return &objectStorageUserListResource{}
+}
+
+func (r *objectStorageUserListResource) Metadata(_ context.Context, _ resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "xelon_object_storage_user"
+}
+
+func (r *objectStorageUserListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = schema.Schema{
+		MarkdownDescription: "The object storage user list resource finds existing Xelon object storage users with `terraform query`.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "List only object storage users whose name contains this value, ignoring case.",
+				Optional:            true,
+			},
+			"region": schema.StringAttribute{
+				MarkdownDescription: "The region of the object storage users. " +
+					"The Xelon API does not return the region, so it is assigned to every listed user.",
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"tenant_id": schema.StringAttribute{
+				MarkdownDescription: "List only object storage users owned by the tenant with this ID.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *objectStorageUserListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	var data objectStorageUserListResourceModel
+
+	diags := request.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	tflog.Debug(ctx, "Getting object storage users", map[string]any{
+		"name":      data.Name.ValueString(),
+		"tenant_id": data.TenantID.ValueString(),
+	})
+	var filteredUsers []xelon.ObjectStorageUser
+	users, errf := r.client.ObjectStorages.AllUsers(ctx, &xelon.ListOptions{PerPage: 100})
+	for user := range users {
+		if listFilterMatches(user.Name, data.Name) && tenantFilterMatches(user.Tenant, data.TenantID) {
+			filteredUsers = append(filteredUsers, user)
+		}
+	}
+	if err := errf(); err != nil {
+		stream.Results = list.ListResultsStreamDiagnostics(diag.Diagnostics{
+			diag.NewErrorDiagnostic("Unable to list object storage users", err.Error()),
+		})
+		return
+	}
+	tflog.Debug(ctx, "Got object storage users", map[string]any{"object_storage_user_count": len(filteredUsers)})
+
+	stream.Results = listResults(ctx, r.client, request, filteredUsers, NewObjectStorageUserResource, func(user xelon.ObjectStorageUser, result *list.ListResult) {
+		result.DisplayName = user.Name
+		result.Diagnostics.Append(result.Identity.Set(ctx, objectStorageUserResourceIdentityModel{
+			ID:     types.StringValue(user.ID),
+			Region: data.Region,
+		})...)
+	})
+}
@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"context"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/Xelon-AG/xelon-sdk-go/xelon"
+)
+
+var (
+	_ list.ListResource              = (*persistentStorageListResource)(nil)
+	_ list.ListResourceWithConfigure = (*persistentStorageListResource)(nil)
+)
+
+// persistentStorageListResource is the persistent storage list resource implementation.
+type persistentStorageListResource struct {
+	withListResults
+}
+
+// persistentStorageListResourceModel maps the persistent storage list resource config schema data.
+type persistentStorageListResourceModel struct {
+	Name     types.String `tfsdk:"name"`
+	TenantID types.String `tfsdk:"tenant_id"`
+}
+
+func NewPersistentStorageListResource() list.ListResource {
+	return &persistentStorageListResource{}
+}
+
+func (r *persistentStorageListResource) Metadata(_ context.Context, _ resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "xelon_persistent_storage"
+}
+
+func (r *persistentStorageListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = schema.Schema{
+		MarkdownDescription: "The persistent storage list resource finds existing Xelon persistent storages with `terraform query`.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "List only persistent storages whose name contains this value, ignoring case.",
+				Optional:            true,
+			},
+			"tenant_id": schema.StringAttribute{
+				MarkdownDescription: "List only persistent storages owned by the tenant with this ID.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *persistentStorageListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	var data persistentStorageListResourceModel
+
+	diags := request.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	tflog.Debug(ctx, "Getting persistent storages", map[string]any{
+		"name":      data.Name.ValueString(),
+		"tenant_id": data.TenantID.ValueString(),
+	})
+	persistentStorages, _, err := r.client.PersistentStorages.List(ctx, &xelon.PersistentStorageListOptions{Search: data.Name.ValueString()})
+	if err != nil {
+		stream.Results = list.ListResultsStreamDiagnostics(diag.Diagnostics{
+			diag.NewErrorDiagnostic("Unable to list persistent storages", err.Error()),
+		})
+		return
+	}
+	persistentStorages = slices.DeleteFunc(persistentStorages, func(persistentStorage xelon.PersistentStorage) bool {
+		return !listFilterMatches(persistentStorage.Name, data.Name) || !tenantFilterMatches(persistentStorage.Tenant, data.TenantID)
+	})
+	tflog.Debug(ctx, "Got persistent storages", map[string]any{"persistent_storage_count": len(persistentStorages)})
+
+	stream.Results = listResults(ctx, r.client, request, persistentStorages, NewPersistentStorageResource, func(persistentStorage xelon.PersistentStorage, result *list.ListResult) {
+		result.DisplayName = persistentStorage.Name
+		result.Diagnostics.Append(result.Identity.Set(ctx, idResourceIdentityModel{ID: types.StringValue(persistentStorage.ID)})...)
+	})
+}
@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"context"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/Xelon-AG/xelon-sdk-go/xelon"
+)
+
+var (
+	_ list.ListResource              = (*sshKeyListResource)(nil)
+	_ list.ListResourceWithConfigure = (*sshKeyListResource)(nil)
+)
+
+// sshKeyListResource is the SSH key list resource implementation.
+type sshKeyListResource struct {
+	withListResults
+}
+
+// sshKeyListResourceModel maps the SSH key list resource config schema data.
+type sshKeyListResourceModel struct {
+	Name types.String `tfsdk:"name"`
+}
+
+func NewSSHKeyListResource() list.ListResource {
+	return &sshKeyListResource{}
+}
+
+func (r *sshKeyListResource) Metadata(_ context.Context, _ resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "xelon_ssh_key"
+}
+
+func (r *sshKeyListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = schema.Schema{
+		MarkdownDescription: "The SSH key list resource finds existing Xelon SSH keys with `terraform query`.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "List only SSH keys whose name contains this value, ignoring case.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *sshKeyListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	var data sshKeyListResourceModel
+
+	diags := request.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	tflog.Debug(ctx, "Getting SSH keys", map[string]any{"name": data.Name.ValueString()})
+	sshKeys, _, err := r.client.SSHKeys.List(ctx, &xelon.SSHKeyListOptions{Search: data.Name.ValueString()})
+	if err != nil {
+		stream.Results = list.ListResultsStreamDiagnostics(diag.Diagnostics{
+			diag.NewErrorDiagnostic("Unable to list SSH keys", err.Error()),
+		})
+		return
+	}
+	sshKeys = slices.DeleteFunc(sshKeys, func(sshKey xelon.SSHKey) bool {
+		return !listFilterMatches(sshKey.Name, data.Name)
+	})
+	tflog.Debug(ctx, "Got SSH keys", map[string]any{"ssh_key_count": len(sshKeys)})
+
+	stream.Results = listResults(ctx, r.client, request, sshKeys, NewSSHKeyResource, func(sshKey xelon.SSHKey, result *list.ListResult) {
+		result.DisplayName = sshKey.Name
+		result.Diagnostics.Append(result.Identity.Set(ctx, idResourceIdentityModel{ID: types.StringValue(sshKey.ID)})...)
+	})
+}
@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"context"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/Xelon-AG/xelon-sdk-go/xelon"
+)
+
+var (
+	_ list.ListResource              = (*templateListResource)(nil)
+	_ list.ListResourceWithConfigure = (*templateListResource)(nil)
+)
+
+// templateListResource is the template list resource implementation.
+type templateListResource struct {
+	withListResults
+}
+
+// templateListResourceModel maps the template list resource config schema data.
+type templateListResourceModel struct {
+	Name types.String `tfsdk:"name"`
+}
+
+func NewTemplateListResource() list.ListResource {
+	return &templateListResource{}
+}
+
+func (r *templateListResource) Metadata(_ context.Context, _ resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "xelon_template"
+}
+
+func (r *templateListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = schema.Schema{
+		MarkdownDescription: "The template list resource finds existing Xelon templates with `terraform query`.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "List only templates whose name contains this value, ignoring case.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *templateListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	var data templateListResourceModel
+
+	diags := request.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	tflog.Debug(ctx, "Getting templates", map[string]any{"name": data.Name.ValueString()})
+	templates, _, err := r.client.Templates.List(ctx, &xelon.TemplateListOptions{Search: data.Name.ValueString()})
+	if err != nil {
+		stream.Results = list.ListResultsStreamDiagnostics(diag.Diagnostics{
+			diag.NewErrorDiagnostic("Unable to list templates", err.Error()),
+		})
+		return
+	}
+	templates = slices.DeleteFunc(templates, func(template xelon.Template) bool {
+		return !listFilterMatches(template.Name, data.Name)
+	})
+	tflog.Debug(ctx, "Got templates", map[string]any{"template_count": len(templates)})
+
+	stream.Results = listResults(ctx, r.client, request, templates, NewTemplateResource, func(template xelon.Template, result *list.ListResult) {
+		result.DisplayName = template.Name
+		result.Diagnostics.Append(result.Identity.Set(ctx, idResourceIdentityModel{ID: types.StringValue(template.ID)})...)
+	})
+}
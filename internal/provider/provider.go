@@ -2,28 +2,70 @@ package provider
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
 	"fmt"
+	"iter"
+	"net"
+	"net/http"
 	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
+	"github.com/Xelon-AG/terraform-provider-xelon/internal/provider/helper"
 	"github.com/Xelon-AG/xelon-sdk-go/xelon"
 )
 
 const (
-	defaultBaseURL = "https://hq.xelon.ch/api/v2/"
+	defaultBaseURL      = "https://hq.xelon.ch/api/v2/"
+	defaultMaxRetries   = 5
+	defaultRetryMaxWait = 30
+
+	// readCacheTTL is the time parent objects shared by several resources,
+	// e.g. firewalls of forwarding rules, are cached within one provider run.
+	readCacheTTL = 10 * time.Second
 )
 
-var _ provider.Provider = (*xelonProvider)(nil)
+var (
+	_ provider.Provider                       = (*xelonProvider)(nil)
+	_ provider.ProviderWithFunctions          = (*xelonProvider)(nil)
+	_ provider.ProviderWithEphemeralResources = (*xelonProvider)(nil)
+	_ provider.ProviderWithListResources      = (*xelonProvider)(nil)
+)
 
 // xelonProvider defines the provider implementation.
 type xelonProvider struct {
+	// defaults holds the configured default_cloud_id and default_tenant_id,
+	// shared with the resources using them
+	defaults *providerDefaults
+	// readOnly is enabled by the read_only attribute, shared with all resources
+	readOnly *readOnlyMode
+
 	// version is set to
 	//  - the provider version on release
 	//  - "dev" when the provider is built and ran locally
@@ -34,7 +76,9 @@ type xelonProvider struct {
 func New(version string) func() provider.Provider {
 	return func() provider.Provider {
 		return &xelonProvider{
-			version: version,
+			defaults: &providerDefaults{},
+			readOnly: &readOnlyMode{},
+			version:  version,
 		}
 	}
 }
@@ -53,27 +97,200 @@ func (p *xelonProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp
 					"Alternatively, can be configured using the `XELON_BASE_URL` environment variable.", defaultBaseURL),
 			},
 
+			"ca_cert_file": schema.StringAttribute{
+				Optional: true,
+				Description: "The path to a PEM encoded CA certificate bundle trusted in addition to the system CAs, " +
+					"e.g. for a TLS-intercepting proxy or a private `base_url`. Alternatively, can be configured using the " +
+					"`XELON_CA_CERT_FILE` environment variable.",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("ca_cert_pem")),
+				},
+			},
+
+			"ca_cert_pem": schema.StringAttribute{
+				Optional:    true,
+				Description: "The PEM encoded CA certificate bundle trusted in addition to the system CAs.",
+			},
+
+			"client_cert_file": schema.StringAttribute{
+				Optional: true,
+				Description: "The path to a PEM encoded client certificate for mutual TLS. Alternatively, can be configured " +
+					"using the `XELON_CLIENT_CERT_FILE` environment variable.",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("client_cert_pem")),
+				},
+			},
+
+			"client_cert_pem": schema.StringAttribute{
+				Optional:    true,
+				Description: "The PEM encoded client certificate for mutual TLS.",
+			},
+
 			"client_id": schema.StringAttribute{
 				Optional: true,
 				Description: "The client ID for IP ranges. Alternatively, can be configured " +
 					"using the `XELON_CLIENT_ID` environment variable.",
 			},
 
+			"client_key_file": schema.StringAttribute{
+				Optional: true,
+				Description: "The path to the PEM encoded private key of the client certificate. Alternatively, can be " +
+					"configured using the `XELON_CLIENT_KEY_FILE` environment variable.",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("client_key_pem")),
+				},
+			},
+
+			"client_key_pem": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The PEM encoded private key of the client certificate.",
+			},
+
+			"default_cloud_id": schema.StringAttribute{
+				Optional: true,
+				Description: "The cloud ID used by resources with omitted `cloud_id`. Alternatively, can be configured " +
+					"using the `XELON_CLOUD_ID` environment variable.",
+			},
+
+			"default_tenant_id": schema.StringAttribute{
+				Optional: true,
+				Description: "The tenant ID used by resources with omitted `tenant_id`. Alternatively, can be configured " +
+					"using the `XELON_TENANT_ID` environment variable.",
+			},
+
+			"insecure_skip_verify": schema.BoolAttribute{
+				Optional: true,
+				Description: "Whether to skip the verification of the Xelon HQ TLS certificate. Use only for testing, " +
+					"as it makes the connection vulnerable to man-in-the-middle attacks. Default is `false`. " +
+					"Alternatively, can be configured using the `XELON_INSECURE_SKIP_VERIFY` environment variable.",
+			},
+
+			"max_concurrent_requests": schema.Int64Attribute{
+				Optional: true,
+				Description: "The maximum number of concurrent API requests, shared by all resources and data sources. " +
+					"Default is `0` (unlimited). Alternatively, can be configured using the `XELON_MAX_CONCURRENT_REQUESTS` " +
+					"environment variable.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+
+			"max_retries": schema.Int64Attribute{
+				Optional: true,
+				Description: fmt.Sprintf("The maximum number of retries of throttled (429) or temporarily unavailable (502, 503, 504) "+
+					"API requests. Requests other than GET, PUT, and DELETE are only retried if throttled. Set to `0` to disable retries. "+
+					"Default is `%d`. Alternatively, can be configured using the `XELON_MAX_RETRIES` environment variable.", defaultMaxRetries),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+
+			"profile": schema.StringAttribute{
+				Optional: true,
+				Description: "The name of the profile in the Xelon config file to read `base_url`, `client_id`, `token`, " +
+					"`default_cloud_id`, and `default_tenant_id` from, if not set in the provider configuration or environment. " +
+					"The config file is `~/.config/xelon/config`, unless set by the `XELON_CONFIG_FILE` environment variable. " +
+					"Alternatively, can be configured using the `XELON_PROFILE` environment variable.",
+			},
+
+			"proxy_url": schema.StringAttribute{
+				Optional: true,
+				Description: "The URL of the HTTP, HTTPS, or SOCKS5 proxy for requests to Xelon HQ. Default is the proxy " +
+					"set by the `HTTP_PROXY`, `HTTPS_PROXY`, and `NO_PROXY` environment variables. Alternatively, can be " +
+					"configured using the `XELON_PROXY_URL` environment variable.",
+			},
+
+			"read_only": schema.BoolAttribute{
+				Optional: true,
+				Description: "Whether to refuse all changes, e.g. for audit or drift detection with `terraform plan`. " +
+					"Creating, updating, or deleting resources fails before any API request, and only GET requests are sent " +
+					"to Xelon HQ. Refresh, import, and data sources keep working. Default is `false`. Alternatively, can be " +
+					"configured using the `XELON_READ_ONLY` environment variable.",
+			},
+
+			"requests_per_second": schema.Float64Attribute{
+				Optional: true,
+				Description: "The maximum number of API requests per second, shared by all resources and data sources. " +
+					"Default is `0` (unlimited). Alternatively, can be configured using the `XELON_REQUESTS_PER_SECOND` " +
+					"environment variable.",
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0),
+				},
+			},
+
+			"retry_max_wait": schema.Int64Attribute{
+				Optional: true,
+				Description: fmt.Sprintf("The maximum time in seconds to wait between two retries, also limiting the time requested "+
+					"by the `Retry-After` header. Default is `%d`. Alternatively, can be configured using the "+
+					"`XELON_RETRY_MAX_WAIT` environment variable.", defaultRetryMaxWait),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+
+			"skip_credentials_validation": schema.BoolAttribute{
+				Optional: true,
+				Description: "Whether to skip validating the credentials and connectivity to Xelon HQ when configuring " +
+					"the provider, e.g. for offline plans. Default is `false`. Alternatively, can be configured using the " +
+					"`XELON_SKIP_CREDENTIALS_VALIDATION` environment variable.",
+			},
+
 			"token": schema.StringAttribute{
 				Optional: true,
 				Description: "The Xelon access token. Alternatively, can be configured " +
 					"using the `XELON_TOKEN` environment variable.",
 			},
+
+			"token_command": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "The command, e.g. `[\"xelon-credential-helper\", \"get\"]`, obtaining the Xelon access token " +
+					"instead of `token`. The command must print a JSON object with the `token` and an optional RFC 3339 " +
+					"`expires_at` time to stdout. The token is cached and the command run again shortly before it expires. " +
+					"The command fails if it does not finish within 2 minutes.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ConflictsWith(path.MatchRoot("token")),
+				},
+			},
 		},
 	}
 }
 
 type providerModel struct {
-	BaseURL  types.String `tfsdk:"base_url"`
-	ClientID types.String `tfsdk:"client_id"`
-	Token    types.String `tfsdk:"token"`
+	BaseURL                   types.String  `tfsdk:"base_url"`
+	CACertFile                types.String  `tfsdk:"ca_cert_file"`
+	CACertPEM                 types.String  `tfsdk:"ca_cert_pem"`
+	ClientCertFile            types.String  `tfsdk:"client_cert_file"`
+	ClientCertPEM             types.String  `tfsdk:"client_cert_pem"`
+	ClientID                  types.String  `tfsdk:"client_id"`
+	ClientKeyFile             types.String  `tfsdk:"client_key_file"`
+	ClientKeyPEM              types.String  `tfsdk:"client_key_pem"`
+	DefaultCloudID            types.String  `tfsdk:"default_cloud_id"`
+	DefaultTenantID           types.String  `tfsdk:"default_tenant_id"`
+	InsecureSkipVerify        types.Bool    `tfsdk:"insecure_skip_verify"`
+	MaxConcurrentRequests     types.Int64   `tfsdk:"max_concurrent_requests"`
+	MaxRetries                types.Int64   `tfsdk:"max_retries"`
+	Profile                   types.String  `tfsdk:"profile"`
+	ProxyURL                  types.String  `tfsdk:"proxy_url"`
+	ReadOnly                  types.Bool    `tfsdk:"read_only"`
+	RequestsPerSecond         types.Float64 `tfsdk:"requests_per_second"`
+	RetryMaxWait              types.Int64   `tfsdk:"retry_max_wait"`
+	SkipCredentialsValidation types.Bool    `tfsdk:"skip_credentials_validation"`
+	Token                     types.String  `tfsdk:"token"`
+	TokenCommand              types.List    `tfsdk:"token_command"`
 }
 
+// sources of provider settings reported in the configuration log
+const (
+	settingSourceAttribute = "attribute"
+	settingSourceCommand   = "command"
+	settingSourceDefault   = "default"
+	settingSourceEnv       = "env"
+	settingSourceProfile   = "profile"
+)
+
 func (p *xelonProvider) Configure(ctx context.Context, request provider.ConfigureRequest, response *provider.ConfigureResponse) {
 	var config providerModel
 
@@ -83,19 +300,115 @@ func (p *xelonProvider) Configure(ctx context.Context, request provider.Configur
 		return
 	}
 
-	// fallback to env if unset
-	if config.BaseURL.IsNull() {
-		if baseURLFromEnv, ok := os.LookupEnv("XELON_BASE_URL"); ok {
-			config.BaseURL = types.StringValue(baseURLFromEnv)
-		} else {
-			config.BaseURL = types.StringValue(defaultBaseURL)
+	// load the profile, if any
+	if config.Profile.IsNull() {
+		config.Profile = types.StringValue(os.Getenv("XELON_PROFILE"))
+	}
+	profile := &helper.ConfigProfile{}
+	if config.Profile.ValueString() != "" {
+		var err error
+		profile, err = loadConfigProfile(config.Profile.ValueString())
+		if err != nil {
+			response.Diagnostics.AddAttributeError(path.Root("profile"), "Invalid provider config", err.Error())
+			return
 		}
 	}
-	if config.ClientID.IsNull() {
-		config.ClientID = types.StringValue(os.Getenv("XELON_CLIENT_ID"))
+
+	// fallback to env, then to the profile if unset
+	sources := map[string]string{
+		"base_url":          resolveStringSetting(&config.BaseURL, "XELON_BASE_URL", profile.BaseURL, defaultBaseURL),
+		"client_id":         resolveStringSetting(&config.ClientID, "XELON_CLIENT_ID", profile.ClientID, ""),
+		"default_cloud_id":  resolveStringSetting(&config.DefaultCloudID, "XELON_CLOUD_ID", profile.DefaultCloudID, ""),
+		"default_tenant_id": resolveStringSetting(&config.DefaultTenantID, "XELON_TENANT_ID", profile.DefaultTenantID, ""),
+		"token":             resolveStringSetting(&config.Token, "XELON_TOKEN", profile.Token, ""),
+	}
+	if config.CACertFile.IsNull() {
+		config.CACertFile = types.StringValue(os.Getenv("XELON_CA_CERT_FILE"))
+	}
+	if config.ClientCertFile.IsNull() {
+		config.ClientCertFile = types.StringValue(os.Getenv("XELON_CLIENT_CERT_FILE"))
+	}
+	if config.ClientKeyFile.IsNull() {
+		config.ClientKeyFile = types.StringValue(os.Getenv("XELON_CLIENT_KEY_FILE"))
+	}
+	if config.InsecureSkipVerify.IsNull() {
+		insecureSkipVerify, err := lookupEnvBool("XELON_INSECURE_SKIP_VERIFY")
+		if err != nil {
+			response.Diagnostics.AddAttributeError(path.Root("insecure_skip_verify"), "Invalid provider config", err.Error())
+			return
+		}
+		config.InsecureSkipVerify = types.BoolValue(insecureSkipVerify)
 	}
-	if config.Token.IsNull() {
-		config.Token = types.StringValue(os.Getenv("XELON_TOKEN"))
+	if config.ProxyURL.IsNull() {
+		config.ProxyURL = types.StringValue(os.Getenv("XELON_PROXY_URL"))
+	}
+	if config.MaxConcurrentRequests.IsNull() {
+		maxConcurrentRequests, err := lookupEnvInt64("XELON_MAX_CONCURRENT_REQUESTS", 0, 0)
+		if err != nil {
+			response.Diagnostics.AddAttributeError(path.Root("max_concurrent_requests"), "Invalid provider config", err.Error())
+			return
+		}
+		config.MaxConcurrentRequests = types.Int64Value(maxConcurrentRequests)
+	}
+	if config.MaxRetries.IsNull() {
+		maxRetries, err := lookupEnvInt64("XELON_MAX_RETRIES", defaultMaxRetries, 0)
+		if err != nil {
+			response.Diagnostics.AddAttributeError(path.Root("max_retries"), "Invalid provider config", err.Error())
+			return
+		}
+		config.MaxRetries = types.Int64Value(maxRetries)
+	}
+	if config.RequestsPerSecond.IsNull() {
+		requestsPerSecond, err := lookupEnvFloat64("XELON_REQUESTS_PER_SECOND", 0, 0)
+		if err != nil {
+			response.Diagnostics.AddAttributeError(path.Root("requests_per_second"), "Invalid provider config", err.Error())
+			return
+		}
+		config.RequestsPerSecond = types.Float64Value(requestsPerSecond)
+	}
+	if config.ReadOnly.IsNull() {
+		readOnly, err := lookupEnvBool("XELON_READ_ONLY")
+		if err != nil {
+			response.Diagnostics.AddAttributeError(path.Root("read_only"), "Invalid provider config", err.Error())
+			return
+		}
+		config.ReadOnly = types.BoolValue(readOnly)
+	}
+	if config.SkipCredentialsValidation.IsNull() {
+		skipCredentialsValidation, err := lookupEnvBool("XELON_SKIP_CREDENTIALS_VALIDATION")
+		if err != nil {
+			response.Diagnostics.AddAttributeError(path.Root("skip_credentials_validation"), "Invalid provider config", err.Error())
+			return
+		}
+		config.SkipCredentialsValidation = types.BoolValue(skipCredentialsValidation)
+	}
+	if config.RetryMaxWait.IsNull() {
+		retryMaxWait, err := lookupEnvInt64("XELON_RETRY_MAX_WAIT", defaultRetryMaxWait, 1)
+		if err != nil {
+			response.Diagnostics.AddAttributeError(path.Root("retry_max_wait"), "Invalid provider config", err.Error())
+			return
+		}
+		config.RetryMaxWait = types.Int64Value(retryMaxWait)
+	}
+
+	// a token command takes precedence over tokens from env or profile
+	var tokenCommand *helper.TokenCommand
+	if !config.TokenCommand.IsNull() {
+		var args []string
+		diags = config.TokenCommand.ElementsAs(ctx, &args, false)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		tokenCommand = helper.NewTokenCommand(args)
+		token, err := tokenCommand.Token(ctx)
+		if err != nil {
+			response.Diagnostics.AddAttributeError(path.Root("token_command"), "Invalid provider config", err.Error())
+			return
+		}
+		config.Token = types.StringValue(token)
+		sources["token"] = settingSourceCommand
 	}
 
 	// required if still unset
@@ -108,21 +421,109 @@ func (p *xelonProvider) Configure(ctx context.Context, request provider.Configur
 		return
 	}
 
+	// build http transport with TLS and proxy settings
+	transportConfig := helper.TransportConfig{
+		InsecureSkipVerify: config.InsecureSkipVerify.ValueBool(),
+		ProxyURL:           config.ProxyURL.ValueString(),
+	}
+	var err error
+	if transportConfig.CACertPEM, err = readPEMSetting(config.CACertPEM, config.CACertFile); err != nil {
+		response.Diagnostics.AddAttributeError(path.Root("ca_cert_file"), "Invalid provider config", err.Error())
+		return
+	}
+	if transportConfig.ClientCertPEM, err = readPEMSetting(config.ClientCertPEM, config.ClientCertFile); err != nil {
+		response.Diagnostics.AddAttributeError(path.Root("client_cert_file"), "Invalid provider config", err.Error())
+		return
+	}
+	if transportConfig.ClientKeyPEM, err = readPEMSetting(config.ClientKeyPEM, config.ClientKeyFile); err != nil {
+		response.Diagnostics.AddAttributeError(path.Root("client_key_file"), "Invalid provider config", err.Error())
+		return
+	}
+	httpTransport, err := helper.NewHTTPTransport(transportConfig)
+	if err != nil {
+		response.Diagnostics.AddError("Invalid provider config", err.Error())
+		return
+	}
+	if transportConfig.InsecureSkipVerify {
+		response.Diagnostics.AddAttributeWarning(
+			path.Root("insecure_skip_verify"),
+			"Insecure connection to Xelon HQ",
+			"TLS certificate verification is disabled, the token and all data sent to Xelon HQ can be intercepted "+
+				"by anyone in the network path. Use insecure_skip_verify only for testing.",
+		)
+	}
+
 	// build xelon sdk client
-	opts := []xelon.ClientOption{xelon.WithUserAgent(p.userAgent())}
+	// every retry attempt counts towards the request limits and is logged,
+	// cached reads aren't
+	var transport http.RoundTripper = helper.NewLimitTransport(
+		&helper.LoggingTransport{Base: httpTransport},
+		int(config.MaxConcurrentRequests.ValueInt64()),
+		config.RequestsPerSecond.ValueFloat64(),
+	)
+	if tokenCommand != nil {
+		// refresh the token before it expires during long-running operations
+		transport = &helper.TokenTransport{Base: transport, Command: tokenCommand}
+	}
+	httpClient := &http.Client{
+		Transport: helper.NewReadCacheTransport(
+			&helper.RetryTransport{
+				Base:       transport,
+				MaxRetries: int(config.MaxRetries.ValueInt64()),
+				MaxWait:    time.Duration(config.RetryMaxWait.ValueInt64()) * time.Second,
+			},
+			readCacheTTL,
+		),
+	}
+	if config.ReadOnly.ValueBool() {
+		// second safety net for changes not guarded by the resources
+		httpClient.Transport = &helper.ReadOnlyTransport{Base: httpClient.Transport}
+	}
+	opts := []xelon.ClientOption{xelon.WithHTTPClient(httpClient), xelon.WithUserAgent(p.userAgent())}
 	opts = append(opts, xelon.WithBaseURL(config.BaseURL.ValueString()))
 	if config.ClientID.ValueString() != "" {
 		opts = append(opts, xelon.WithClientID(config.ClientID.ValueString()))
 	}
 	client := xelon.NewClient(config.Token.ValueString(), opts...)
 
+	// fail early on wrong credentials or base_url instead of in the first resource
+	tenant := &xelon.Tenant{}
+	if !config.SkipCredentialsValidation.ValueBool() {
+		tflog.Debug(ctx, "Validating Xelon credentials", map[string]any{"base_url": config.BaseURL.ValueString()})
+		var diagnostic diag.Diagnostic
+		tenant, diagnostic = validateCredentials(ctx, client, config.BaseURL.ValueString())
+		if diagnostic != nil {
+			response.Diagnostics.Append(diagnostic)
+			return
+		}
+	}
+
 	tflog.Info(ctx, "Xelon SDK client configured", map[string]interface{}{
-		"base_url":          config.BaseURL.ValueString(),
-		"client_id":         config.ClientID.ValueString(),
-		"terraform_version": request.TerraformVersion,
+		"base_url":                    config.BaseURL.ValueString(),
+		"client_id":                   config.ClientID.ValueString(),
+		"default_cloud_id":            config.DefaultCloudID.ValueString(),
+		"default_tenant_id":           config.DefaultTenantID.ValueString(),
+		"insecure_skip_verify":        config.InsecureSkipVerify.ValueBool(),
+		"max_concurrent_requests":     config.MaxConcurrentRequests.ValueInt64(),
+		"max_retries":                 config.MaxRetries.ValueInt64(),
+		"profile":                     config.Profile.ValueString(),
+		"proxy_url":                   helper.RedactedURL(config.ProxyURL.ValueString()),
+		"read_only":                   config.ReadOnly.ValueBool(),
+		"requests_per_second":         config.RequestsPerSecond.ValueFloat64(),
+		"retry_max_wait":              config.RetryMaxWait.ValueInt64(),
+		"setting_sources":             sources,
+		"skip_credentials_validation": config.SkipCredentialsValidation.ValueBool(),
+		"tenant_id":                   tenant.ID,
+		"tenant_name":                 tenant.Name,
+		"terraform_version":           request.TerraformVersion,
 	})
 
+	p.defaults.set(config.DefaultCloudID, config.DefaultTenantID)
+	p.readOnly.enabled.Store(config.ReadOnly.ValueBool())
+
 	response.DataSourceData = client
+	response.EphemeralResourceData = client
+	response.ListResourceData = client
 	response.ResourceData = client
 }
 
@@ -130,6 +531,9 @@ func (p *xelonProvider) DataSources(_ context.Context) []func() datasource.DataS
 	return []func() datasource.DataSource{
 		NewBackupPlanDataSource,
 		NewCloudDataSource,
+		NewDeviceDataSource,
+		NewDevicesDataSource,
+		NewDNSZoneFileDataSource,
 		NewISODataSource,
 		NewKubernetesClusterDataSource,
 		NewKubernetesClusterVersionsDataSource,
@@ -143,10 +547,11 @@ func (p *xelonProvider) DataSources(_ context.Context) []func() datasource.DataS
 }
 
 func (p *xelonProvider) Resources(_ context.Context) []func() resource.Resource {
-	return []func() resource.Resource{
+	resources := []func() resource.Resource{
 		NewDeviceResource,
 		NewDeviceBackupResource,
 		NewDNSRecordResource,
+		NewDNSRecordsResource,
 		NewDNSSOAResource,
 		NewDNSZoneResource,
 		NewFirewallResource,
@@ -165,6 +570,300 @@ func (p *xelonProvider) Resources(_ context.Context) []func() resource.Resource
 		NewTemplateResource,
 		NewTenantUserResource,
 	}
+
+	// pass the provider defaults before the resource schema with its plan
+	// modifiers is built
+	for i, newResource := range resources {
+		resources[i] = func() resource.Resource {
+			r := newResource()
+			if r, ok := r.(resourceWithProviderDefaults); ok {
+				r.setProviderDefaults(p.defaults)
+			}
+			if r, ok := r.(resourceWithReadOnlyMode); ok {
+				r.setReadOnlyMode(p.readOnly)
+			}
+			return r
+		}
+	}
+
+	return resources
+}
+
+func (p *xelonProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewKubernetesClusterKubeconfigEphemeralResource,
+		NewKubernetesClusterTalosconfigEphemeralResource,
+	}
+}
+
+func (p *xelonProvider) ListResources(_ context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		NewDeviceListResource,
+		NewDNSRecordListResource,
+		NewDNSZoneListResource,
+		NewFirewallListResource,
+		NewISOListResource,
+		NewLoadBalancerListResource,
+		NewNetworkListResource,
+		NewObjectStorageBucketListResource,
+		NewObjectStorageUserListResource,
+		NewPersistentStorageListResource,
+		NewSSHKeyListResource,
+		NewTemplateListResource,
+	}
+}
+
+func (p *xelonProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewParseDNSZoneFileFunction,
+	}
+}
+
+// providerDefaults holds the attribute defaults configured in the provider.
+// Resources read them at plan time, after the provider has been configured.
+type providerDefaults struct {
+	mu       sync.RWMutex
+	cloudID  types.String
+	tenantID types.String
+}
+
+func (d *providerDefaults) set(cloudID, tenantID types.String) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.cloudID = cloudID
+	d.tenantID = tenantID
+}
+
+// CloudID returns the default cloud ID, null or empty if not set.
+func (d *providerDefaults) CloudID() types.String {
+	if d == nil {
+		return types.StringNull()
+	}
+
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	return d.cloudID
+}
+
+// TenantID returns the default tenant ID, null or empty if not set.
+func (d *providerDefaults) TenantID() types.String {
+	if d == nil {
+		return types.StringNull()
+	}
+
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	return d.tenantID
+}
+
+// resourceWithProviderDefaults is implemented by resources using the provider
+// defaults, usually by embedding withProviderDefaults.
+type resourceWithProviderDefaults interface {
+	setProviderDefaults(defaults *providerDefaults)
+}
+
+type withProviderDefaults struct {
+	defaults *providerDefaults
+}
+
+func (r *withProviderDefaults) setProviderDefaults(defaults *providerDefaults) {
+	r.defaults = defaults
+}
+
+// cloudIDPlanModifier returns a plan modifier setting an omitted cloud_id to
+// the provider default_cloud_id.
+func (r *withProviderDefaults) cloudIDPlanModifier(required bool) planmodifier.String {
+	return helper.ProviderDefault("default_cloud_id", r.defaults.CloudID, required)
+}
+
+// tenantIDPlanModifier returns a plan modifier setting an omitted tenant_id to
+// the provider default_tenant_id.
+func (r *withProviderDefaults) tenantIDPlanModifier(required bool) planmodifier.String {
+	return helper.ProviderDefault("default_tenant_id", r.defaults.TenantID, required)
+}
+
+// readOnlyMode is enabled by the provider read_only attribute. Resources
+// check it before creating, updating, or deleting objects.
+type readOnlyMode struct {
+	enabled atomic.Bool
+}
+
+// resourceWithReadOnlyMode is implemented by resources embedding
+// withReadOnlyMode.
+type resourceWithReadOnlyMode interface {
+	setReadOnlyMode(mode *readOnlyMode)
+}
+
+type withReadOnlyMode struct {
+	readOnly *readOnlyMode
+}
+
+func (r *withReadOnlyMode) setReadOnlyMode(mode *readOnlyMode) {
+	r.readOnly = mode
+}
+
+// checkReadOnly adds an error and returns true if the provider is read-only,
+// so that the operation must not be run.
+func (r *withReadOnlyMode) checkReadOnly(operation string, diags *diag.Diagnostics) bool {
+	if r.readOnly == nil || !r.readOnly.enabled.Load() {
+		return false
+	}
+
+	diags.AddError(
+		"Xelon provider is read-only",
+		fmt.Sprintf("Unable to %s the resource, because read_only is enabled in the provider configuration. "+
+			"Disable read_only or unset the XELON_READ_ONLY environment variable to apply changes.", operation),
+	)
+	return true
+}
+
+// idResourceIdentityModel maps the identity of resources identified by their
+// ID only.
+type idResourceIdentityModel struct {
+	ID types.String `tfsdk:"id"`
+}
+
+// idIdentitySchema returns the identity schema of resources identified by
+// their ID only.
+func idIdentitySchema(description string) identityschema.Schema {
+	return identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       description,
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+// setResourceIdentity sets the identity of a resource after it was created,
+// read, imported, or updated. The identity is nil if the resource is called
+// outside the framework, e.g. in unit tests.
+func setResourceIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity, value any, diags *diag.Diagnostics) {
+	if identity == nil {
+		return
+	}
+
+	diags.Append(identity.Set(ctx, value)...)
+}
+
+// withListResults is embedded by list resources, holding the Xelon client
+// used to list the objects.
+type withListResults struct {
+	client *xelon.Client
+}
+
+func (r *withListResults) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	client, ok := request.ProviderData.(*xelon.Client)
+	if !ok {
+		response.Diagnostics.AddError(
+			"Unconfigured Xelon client",
+			"Please report this issue to the provider developers.",
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// listResults returns a list result for each object up to the limit of the
+// request. setResult sets the identity and display name of the result.
+func listResults[T any](ctx context.Context, client *xelon.Client, request list.ListRequest, objects []T, newResource func() resource.Resource, setResult func(T, *list.ListResult)) iter.Seq[list.ListResult] {
+	return func(push func(list.ListResult) bool) {
+		for i, object := range objects {
+			if request.Limit > 0 && int64(i) >= request.Limit {
+				return
+			}
+
+			result := request.NewListResult(ctx)
+			setResult(object, &result)
+			if request.IncludeResource && !result.Diagnostics.HasError() {
+				readListResultResource(ctx, client, request, newResource(), &result)
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
+
+// readListResultResource sets the resource of a list result by importing and
+// reading the listed object with its managed resource, so that the generated
+// configuration matches the one of an imported resource.
+func readListResultResource(ctx context.Context, client *xelon.Client, request list.ListRequest, r resource.Resource, result *list.ListResult) {
+	state := tfsdk.State{
+		Schema: request.ResourceSchema,
+		Raw:    tftypes.NewValue(request.ResourceSchema.Type().TerraformType(ctx), nil),
+	}
+	var diags diag.Diagnostics
+	result.Resource, diags = importResource(ctx, client, r, state, result.Identity)
+	result.Diagnostics.Append(diags...)
+}
+
+// importResource imports the object of the identity into the null state and
+// reads it with its managed resource, like Terraform does for an import
+// block. It returns nil if the object no longer exists.
+func importResource(ctx context.Context, client *xelon.Client, r resource.Resource, state tfsdk.State, identity *tfsdk.ResourceIdentity) (*tfsdk.Resource, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if r, ok := r.(resource.ResourceWithConfigure); ok {
+		configureResponse := &resource.ConfigureResponse{}
+		r.Configure(ctx, resource.ConfigureRequest{ProviderData: client}, configureResponse)
+		diags.Append(configureResponse.Diagnostics...)
+	}
+
+	importResponse := &resource.ImportStateResponse{
+		State: state,
+		Identity: &tfsdk.ResourceIdentity{
+			Schema: identity.Schema,
+			Raw:    identity.Raw.Copy(),
+		},
+	}
+	if r, ok := r.(resource.ResourceWithImportState); ok {
+		r.ImportState(ctx, resource.ImportStateRequest{Identity: identity}, importResponse)
+		diags.Append(importResponse.Diagnostics...)
+	}
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	readResponse := &resource.ReadResponse{
+		State:    importResponse.State,
+		Identity: importResponse.Identity,
+	}
+	r.Read(ctx, resource.ReadRequest{State: importResponse.State, Identity: importResponse.Identity}, readResponse)
+	diags.Append(readResponse.Diagnostics...)
+	if diags.HasError() || readResponse.State.Raw.IsNull() {
+		return nil, diags
+	}
+
+	return &tfsdk.Resource{
+		Schema: readResponse.State.Schema,
+		Raw:    readResponse.State.Raw,
+	}, diags
+}
+
+// listFilterMatches reports whether value contains the optional filter,
+// ignoring case. An empty filter matches all values.
+func listFilterMatches(value string, filter types.String) bool {
+	return filter.ValueString() == "" || strings.Contains(strings.ToLower(value), strings.ToLower(filter.ValueString()))
+}
+
+// tenantFilterMatches reports whether the tenant matches the optional
+// tenant_id filter. An empty filter matches all tenants.
+func tenantFilterMatches(tenant *xelon.Tenant, filter types.String) bool {
+	if filter.ValueString() == "" {
+		return true
+	}
+	return tenant != nil && tenant.ID == filter.ValueString()
 }
 
 func (p *xelonProvider) userAgent() string {
@@ -173,3 +872,159 @@ func (p *xelonProvider) userAgent() string {
 
 	return fmt.Sprintf("%s/%s (+%s)", name, p.version, comment)
 }
+
+// loadConfigProfile returns the profile with the given name from the Xelon
+// config file.
+func loadConfigProfile(name string) (*helper.ConfigProfile, error) {
+	configFilePath, ok := os.LookupEnv("XELON_CONFIG_FILE")
+	if !ok {
+		var err error
+		configFilePath, err = helper.DefaultConfigFilePath()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return helper.LoadConfigProfile(configFilePath, name)
+}
+
+// resolveStringSetting sets an unset setting from the environment variable
+// envKey, then from the profile value and finally to defaultValue. It returns
+// the source of the resulting value.
+func resolveStringSetting(setting *types.String, envKey, profileValue, defaultValue string) string {
+	if !setting.IsNull() {
+		return settingSourceAttribute
+	}
+	// an empty variable is treated as unset, e.g. if exported by a CI template
+	if value := os.Getenv(envKey); value != "" {
+		*setting = types.StringValue(value)
+		return settingSourceEnv
+	}
+	if profileValue != "" {
+		*setting = types.StringValue(profileValue)
+		return settingSourceProfile
+	}
+
+	*setting = types.StringValue(defaultValue)
+	return settingSourceDefault
+}
+
+// readPEMSetting returns the PEM content set inline or read from the file.
+func readPEMSetting(content, file types.String) ([]byte, error) {
+	if content.ValueString() != "" {
+		return []byte(content.ValueString()), nil
+	}
+	if file.ValueString() == "" {
+		return nil, nil
+	}
+
+	data, err := os.ReadFile(file.ValueString())
+	if err != nil {
+		return nil, fmt.Errorf("reading PEM file: %w", err)
+	}
+
+	return data, nil
+}
+
+// validateCredentials checks the credentials and connectivity by getting the
+// current tenant. Failures are turned into a diagnostic explaining the cause.
+func validateCredentials(ctx context.Context, client *xelon.Client, baseURL string) (*xelon.Tenant, diag.Diagnostic) {
+	tenant, resp, err := client.Tenants.GetCurrent(ctx)
+	if err == nil {
+		return tenant, nil
+	}
+
+	const skipHint = "\n\nSet skip_credentials_validation to skip this check, e.g. for offline plans."
+	var dnsError *net.DNSError
+	switch {
+	case resp != nil && (resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden):
+		return nil, diag.NewErrorDiagnostic(
+			"Invalid Xelon credentials",
+			fmt.Sprintf("Xelon HQ at %s rejected the token with status %d. Check the token, token_command, or profile "+
+				"of the provider configuration.\n\nError: %s", baseURL, resp.StatusCode, err),
+		)
+	case errors.As(err, &dnsError):
+		return nil, diag.NewErrorDiagnostic(
+			"Unable to connect to Xelon HQ",
+			fmt.Sprintf("The host of base_url %s could not be resolved.\n\nError: %s"+skipHint, baseURL, err),
+		)
+	case isTLSError(err):
+		return nil, diag.NewErrorDiagnostic(
+			"Unable to connect to Xelon HQ",
+			fmt.Sprintf("The TLS certificate of base_url %s could not be verified.\n\nError: %s"+skipHint, baseURL, err),
+		)
+	case resp == nil:
+		return nil, diag.NewErrorDiagnostic(
+			"Unable to connect to Xelon HQ",
+			fmt.Sprintf("Sending a request to base_url %s failed.\n\nError: %s"+skipHint, baseURL, err),
+		)
+	default:
+		return nil, diag.NewErrorDiagnostic(
+			"Unable to validate Xelon credentials",
+			fmt.Sprintf("Getting the current tenant from %s failed with status %d.\n\nError: %s"+skipHint, baseURL, resp.StatusCode, err),
+		)
+	}
+}
+
+// isTLSError reports whether err is caused by an invalid TLS certificate.
+func isTLSError(err error) bool {
+	var certificateVerificationError *tls.CertificateVerificationError
+	var hostnameError x509.HostnameError
+	var unknownAuthorityError x509.UnknownAuthorityError
+	var certificateInvalidError x509.CertificateInvalidError
+	var recordHeaderError tls.RecordHeaderError
+
+	return errors.As(err, &certificateVerificationError) ||
+		errors.As(err, &hostnameError) ||
+		errors.As(err, &unknownAuthorityError) ||
+		errors.As(err, &certificateInvalidError) ||
+		errors.As(err, &recordHeaderError)
+}
+
+// lookupEnvBool returns the value of the environment variable key as boolean,
+// or false if the variable is not set.
+func lookupEnvBool(key string) (bool, error) {
+	value, ok := os.LookupEnv(key)
+	if !ok {
+		return false, nil
+	}
+
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, fmt.Errorf("%s must be a boolean, got %q", key, value)
+	}
+
+	return b, nil
+}
+
+// lookupEnvInt64 returns the value of the environment variable key as integer,
+// or defaultValue if the variable is not set.
+func lookupEnvInt64(key string, defaultValue, minValue int64) (int64, error) {
+	value, ok := os.LookupEnv(key)
+	if !ok {
+		return defaultValue, nil
+	}
+
+	number, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || number < minValue {
+		return 0, fmt.Errorf("%s must be an integer of at least %d, got %q", key, minValue, value)
+	}
+
+	return number, nil
+}
+
+// lookupEnvFloat64 returns the value of the environment variable key as
+// number, or defaultValue if the variable is not set.
+func lookupEnvFloat64(key string, defaultValue, minValue float64) (float64, error) {
+	value, ok := os.LookupEnv(key)
+	if !ok {
+		return defaultValue, nil
+	}
+
+	number, err := strconv.ParseFloat(value, 64)
+	if err != nil || number < minValue {
+		return 0, fmt.Errorf("%s must be a number of at least %v, got %q", key, minValue, value)
+	}
+
+	return number, nil
+}
@@ -1,15 +1,36 @@
 package provider
 
 import (
+	"context"
+	"fmt"
+	"maps"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"path/filepath"
 	"regexp"
+	"slices"
 	"testing"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	fwprovider "github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Xelon-AG/xelon-sdk-go/xelon"
 )
 
 const accTestPrefix = "tf-acc-test"
@@ -76,6 +97,574 @@ func TestProvider_userAgent(t *testing.T) {
 	}
 }
 
+func TestProvider_lookupEnvInt64(t *testing.T) {
+	t.Run("unset", func(t *testing.T) {
+		value, err := lookupEnvInt64("XELON_TEST_INT64", 5, 0)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(5), value)
+	})
+
+	t.Run("set", func(t *testing.T) {
+		t.Setenv("XELON_TEST_INT64", "0")
+
+		value, err := lookupEnvInt64("XELON_TEST_INT64", 5, 0)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(0), value)
+	})
+
+	for _, invalidValue := range []string{"", "five", "-1"} {
+		t.Run("invalid "+invalidValue, func(t *testing.T) {
+			t.Setenv("XELON_TEST_INT64", invalidValue)
+
+			_, err := lookupEnvInt64("XELON_TEST_INT64", 5, 0)
+
+			assert.EqualError(t, err, fmt.Sprintf("XELON_TEST_INT64 must be an integer of at least 0, got %q", invalidValue))
+		})
+	}
+}
+
+func TestProvider_lookupEnvFloat64(t *testing.T) {
+	t.Run("unset", func(t *testing.T) {
+		value, err := lookupEnvFloat64("XELON_TEST_FLOAT64", 0, 0)
+
+		assert.NoError(t, err)
+		assert.Equal(t, float64(0), value)
+	})
+
+	t.Run("set", func(t *testing.T) {
+		t.Setenv("XELON_TEST_FLOAT64", "2.5")
+
+		value, err := lookupEnvFloat64("XELON_TEST_FLOAT64", 0, 0)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2.5, value)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Setenv("XELON_TEST_FLOAT64", "-0.5")
+
+		_, err := lookupEnvFloat64("XELON_TEST_FLOAT64", 0, 0)
+
+		assert.EqualError(t, err, `XELON_TEST_FLOAT64 must be a number of at least 0, got "-0.5"`)
+	})
+}
+
+func TestProvider_resolveStringSetting(t *testing.T) {
+	testCases := map[string]struct {
+		setting        types.String
+		env            string
+		emptyEnv       bool
+		profileValue   string
+		expectedValue  string
+		expectedSource string
+	}{
+		"attribute": {
+			setting:        types.StringValue("from-attribute"),
+			env:            "from-env",
+			profileValue:   "from-profile",
+			expectedValue:  "from-attribute",
+			expectedSource: "attribute",
+		},
+		"env": {
+			setting:        types.StringNull(),
+			env:            "from-env",
+			profileValue:   "from-profile",
+			expectedValue:  "from-env",
+			expectedSource: "env",
+		},
+		"profile": {
+			setting:        types.StringNull(),
+			profileValue:   "from-profile",
+			expectedValue:  "from-profile",
+			expectedSource: "profile",
+		},
+		"empty env": {
+			setting:        types.StringNull(),
+			emptyEnv:       true,
+			profileValue:   "from-profile",
+			expectedValue:  "from-profile",
+			expectedSource: "profile",
+		},
+		"default": {
+			setting:        types.StringNull(),
+			expectedValue:  "from-default",
+			expectedSource: "default",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if testCase.env != "" || testCase.emptyEnv {
+				t.Setenv("XELON_TEST_SETTING", testCase.env)
+			}
+			setting := testCase.setting
+
+			source := resolveStringSetting(&setting, "XELON_TEST_SETTING", testCase.profileValue, "from-default")
+
+			assert.Equal(t, testCase.expectedSource, source)
+			assert.Equal(t, testCase.expectedValue, setting.ValueString())
+		})
+	}
+}
+
+func TestProvider_loadConfigProfile(t *testing.T) {
+	configFilePath := filepath.Join(t.TempDir(), "config")
+	err := os.WriteFile(configFilePath, []byte("[staging]\ntoken = staging-token\n"), 0o600)
+	require.NoError(t, err)
+	t.Setenv("XELON_CONFIG_FILE", configFilePath)
+
+	profile, err := loadConfigProfile("staging")
+
+	require.NoError(t, err)
+	assert.Equal(t, "staging-token", profile.Token)
+}
+
+func TestProvider_lookupEnvBool(t *testing.T) {
+	t.Run("unset", func(t *testing.T) {
+		value, err := lookupEnvBool("XELON_TEST_BOOL")
+
+		assert.NoError(t, err)
+		assert.False(t, value)
+	})
+
+	t.Run("set", func(t *testing.T) {
+		t.Setenv("XELON_TEST_BOOL", "true")
+
+		value, err := lookupEnvBool("XELON_TEST_BOOL")
+
+		assert.NoError(t, err)
+		assert.True(t, value)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Setenv("XELON_TEST_BOOL", "yes please")
+
+		_, err := lookupEnvBool("XELON_TEST_BOOL")
+
+		assert.EqualError(t, err, `XELON_TEST_BOOL must be a boolean, got "yes please"`)
+	})
+}
+
+func TestProvider_readPEMSetting(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "ca.pem")
+	require.NoError(t, os.WriteFile(file, []byte("from file"), 0o600))
+
+	content, err := readPEMSetting(types.StringValue("inline"), types.StringValue(file))
+	require.NoError(t, err)
+	assert.Equal(t, "inline", string(content))
+
+	content, err = readPEMSetting(types.StringNull(), types.StringValue(file))
+	require.NoError(t, err)
+	assert.Equal(t, "from file", string(content))
+
+	content, err = readPEMSetting(types.StringNull(), types.StringValue(""))
+	require.NoError(t, err)
+	assert.Nil(t, content)
+
+	_, err = readPEMSetting(types.StringNull(), types.StringValue(filepath.Join(t.TempDir(), "missing.pem")))
+	assert.ErrorContains(t, err, "reading PEM file")
+}
+
+func TestProvider_checkReadOnly(t *testing.T) {
+	r := &withReadOnlyMode{}
+	var diags diag.Diagnostics
+
+	assert.False(t, r.checkReadOnly("create", &diags))
+	assert.False(t, diags.HasError())
+
+	r.setReadOnlyMode(&readOnlyMode{})
+	r.readOnly.enabled.Store(true)
+
+	assert.True(t, r.checkReadOnly("create", &diags))
+	assert.True(t, diags.HasError())
+	assert.Equal(t, "Xelon provider is read-only", diags[0].Summary())
+}
+
+func TestProvider_ResourceIdentity(t *testing.T) {
+	ctx := context.Background()
+
+	for _, newResource := range New("test")().Resources(ctx) {
+		r := newResource()
+		metadataResponse := &fwresource.MetadataResponse{}
+		r.Metadata(ctx, fwresource.MetadataRequest{ProviderTypeName: "xelon"}, metadataResponse)
+
+		if _, ok := r.(fwresource.ResourceWithImportState); !ok {
+			continue
+		}
+
+		t.Run(metadataResponse.TypeName, func(t *testing.T) {
+			identityResource, ok := r.(fwresource.ResourceWithIdentity)
+			require.True(t, ok, "importable resources must declare an identity")
+
+			identitySchemaResponse := &fwresource.IdentitySchemaResponse{}
+			identityResource.IdentitySchema(ctx, fwresource.IdentitySchemaRequest{}, identitySchemaResponse)
+			require.False(t, identitySchemaResponse.Diagnostics.HasError())
+			require.False(t, identitySchemaResponse.IdentitySchema.ValidateImplementation(ctx).HasError())
+
+			schemaResponse := &fwresource.SchemaResponse{}
+			r.Schema(ctx, fwresource.SchemaRequest{}, schemaResponse)
+			require.False(t, schemaResponse.Diagnostics.HasError())
+
+			// every identity attribute mirrors a resource attribute
+			for name, attribute := range identitySchemaResponse.IdentitySchema.Attributes {
+				resourceAttribute, ok := schemaResponse.Schema.Attributes[name]
+				require.True(t, ok, "identity attribute %q is not a resource attribute", name)
+				assert.Equal(t, resourceAttribute.GetType(), attribute.GetType(), name)
+				assert.True(t, attribute.IsRequiredForImport(), name)
+			}
+		})
+	}
+}
+
+func TestProvider_ListResources(t *testing.T) {
+	ctx := context.Background()
+	p := New("test")()
+
+	identityResources := make(map[string]bool)
+	for _, newResource := range p.Resources(ctx) {
+		r := newResource()
+		metadataResponse := &fwresource.MetadataResponse{}
+		r.Metadata(ctx, fwresource.MetadataRequest{ProviderTypeName: "xelon"}, metadataResponse)
+		_, ok := r.(fwresource.ResourceWithIdentity)
+		identityResources[metadataResponse.TypeName] = ok
+	}
+
+	listProvider, ok := p.(fwprovider.ProviderWithListResources)
+	require.True(t, ok)
+	for _, newListResource := range listProvider.ListResources(ctx) {
+		r := newListResource()
+		metadataResponse := &fwresource.MetadataResponse{}
+		r.Metadata(ctx, fwresource.MetadataRequest{ProviderTypeName: "xelon"}, metadataResponse)
+
+		t.Run(metadataResponse.TypeName, func(t *testing.T) {
+			assert.True(t, identityResources[metadataResponse.TypeName], "list resources must match a resource with an identity")
+
+			schemaResponse := &list.ListResourceSchemaResponse{}
+			r.ListResourceConfigSchema(ctx, list.ListResourceSchemaRequest{}, schemaResponse)
+			require.False(t, schemaResponse.Diagnostics.HasError())
+			require.False(t, schemaResponse.Schema.ValidateImplementation(ctx).HasError())
+		})
+	}
+}
+
+// testListResults lists the objects of the fake server with the list resource
+// r, setting the config attributes and leaving the others null.
+func testListResults(t *testing.T, server *fakeXelonServer, r list.ListResource, newResource func() fwresource.Resource, config map[string]tftypes.Value, limit int64, includeResource bool) []list.ListResult {
+	t.Helper()
+	ctx := context.Background()
+
+	client := xelon.NewClient("fake-token", xelon.WithBaseURL(server.BaseURL()), xelon.WithClientID("fake-client-id"))
+	configureResponse := &fwresource.ConfigureResponse{}
+	r.(list.ListResourceWithConfigure).Configure(ctx, fwresource.ConfigureRequest{ProviderData: client}, configureResponse)
+	require.False(t, configureResponse.Diagnostics.HasError())
+
+	configSchemaResponse := &list.ListResourceSchemaResponse{}
+	r.ListResourceConfigSchema(ctx, list.ListResourceSchemaRequest{}, configSchemaResponse)
+	values := make(map[string]tftypes.Value)
+	for name, attribute := range configSchemaResponse.Schema.Attributes {
+		values[name] = tftypes.NewValue(attribute.GetType().TerraformType(ctx), nil)
+	}
+	maps.Copy(values, config)
+
+	resourceSchemaResponse := &fwresource.SchemaResponse{}
+	newResource().Schema(ctx, fwresource.SchemaRequest{}, resourceSchemaResponse)
+	identitySchemaResponse := &fwresource.IdentitySchemaResponse{}
+	newResource().(fwresource.ResourceWithIdentity).IdentitySchema(ctx, fwresource.IdentitySchemaRequest{}, identitySchemaResponse)
+
+	stream := &list.ListResultsStream{}
+	r.List(ctx, list.ListRequest{
+		Config: tfsdk.Config{
+			Schema: configSchemaResponse.Schema,
+			Raw:    tftypes.NewValue(configSchemaResponse.Schema.Type().TerraformType(ctx), values),
+		},
+		IncludeResource:        includeResource,
+		Limit:                  limit,
+		ResourceSchema:         resourceSchemaResponse.Schema,
+		ResourceIdentitySchema: identitySchemaResponse.IdentitySchema,
+	}, stream)
+
+	results := slices.Collect(stream.Results)
+	for _, result := range results {
+		require.False(t, result.Diagnostics.HasError(), "%v", result.Diagnostics)
+	}
+	return results
+}
+
+func TestProvider_listFilterMatches(t *testing.T) {
+	tests := map[string]struct {
+		value    string
+		filter   types.String
+		expected bool
+	}{
+		"null filter":  {value: "web-01", filter: types.StringNull(), expected: true},
+		"empty filter": {value: "web-01", filter: types.StringValue(""), expected: true},
+		"substring":    {value: "web-01", filter: types.StringValue("web"), expected: true},
+		"ignores case": {value: "Web-01", filter: types.StringValue("WEB"), expected: true},
+		"no match":     {value: "web-01", filter: types.StringValue("db"), expected: false},
+		"empty value":  {value: "", filter: types.StringValue("web"), expected: false},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.expected, listFilterMatches(test.value, test.filter))
+		})
+	}
+}
+
+func TestProvider_tenantFilterMatches(t *testing.T) {
+	tenant := &xelon.Tenant{ID: "tenant-1"}
+
+	assert.True(t, tenantFilterMatches(nil, types.StringNull()))
+	assert.True(t, tenantFilterMatches(tenant, types.StringNull()))
+	assert.True(t, tenantFilterMatches(tenant, types.StringValue("tenant-1")))
+	assert.False(t, tenantFilterMatches(tenant, types.StringValue("tenant-2")))
+	assert.False(t, tenantFilterMatches(nil, types.StringValue("tenant-1")))
+}
+
+func TestProvider_validateCredentials(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{}`))
+		}))
+		t.Cleanup(server.Close)
+		client := xelon.NewClient("token", xelon.WithBaseURL(server.URL+"/"))
+
+		_, diagnostic := validateCredentials(context.Background(), client, server.URL)
+
+		assert.Nil(t, diagnostic)
+	})
+
+	t.Run("unauthorized", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusUnauthorized)
+		}))
+		t.Cleanup(server.Close)
+		client := xelon.NewClient("token", xelon.WithBaseURL(server.URL+"/"))
+
+		_, diagnostic := validateCredentials(context.Background(), client, server.URL)
+
+		require.NotNil(t, diagnostic)
+		assert.Equal(t, "Invalid Xelon credentials", diagnostic.Summary())
+		assert.Contains(t, diagnostic.Detail(), "rejected the token with status 401")
+	})
+
+	t.Run("untrusted certificate", func(t *testing.T) {
+		server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}))
+		t.Cleanup(server.Close)
+		client := xelon.NewClient("token", xelon.WithBaseURL(server.URL+"/"))
+
+		_, diagnostic := validateCredentials(context.Background(), client, server.URL)
+
+		require.NotNil(t, diagnostic)
+		assert.Equal(t, "Unable to connect to Xelon HQ", diagnostic.Summary())
+		assert.Contains(t, diagnostic.Detail(), "TLS certificate")
+	})
+}
+
+func TestProvider_ValidatesCredentials(t *testing.T) {
+	server := newFakeXelonServer(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					server.InjectError(http.MethodGet, "tenants/current", http.StatusUnauthorized, 1)
+				},
+				Config:      server.ProviderConfig() + testProviderConfigTenant,
+				ExpectError: regexp.MustCompile(`Invalid Xelon credentials`),
+			},
+		},
+	})
+}
+
+func TestProvider_SkipCredentialsValidation(t *testing.T) {
+	t.Setenv("XELON_SKIP_CREDENTIALS_VALIDATION", "true")
+
+	server := newFakeXelonServer(t)
+	server.Mutate(func(s *fakeXelonServer) {
+		s.dnsZones["1"] = &xelon.DNSZone{ID: "1", Name: "example.com"}
+		s.dnsSOAs["1"] = &xelon.DNSSOA{PrimaryNS: "ns1.xdns.cloud", TTL: 3600}
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					server.InjectError(http.MethodGet, "tenants/current", http.StatusUnauthorized, 1)
+				},
+				Config: server.ProviderConfig() + testProviderConfigDNSZoneFile,
+			},
+		},
+	})
+}
+
+func TestProvider_RetriesTransientErrors(t *testing.T) {
+	t.Setenv("XELON_MAX_RETRIES", "2")
+	t.Setenv("XELON_RETRY_MAX_WAIT", "1")
+
+	server := newFakeXelonServer(t)
+	server.Mutate(func(s *fakeXelonServer) {
+		s.dnsZones["1"] = &xelon.DNSZone{ID: "1", Name: "example.com"}
+		s.dnsSOAs["1"] = &xelon.DNSSOA{PrimaryNS: "ns1.xdns.cloud", TTL: 3600}
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// transient errors are retried
+			{
+				PreConfig: func() {
+					server.InjectError(http.MethodGet, "dns/zones/1", http.StatusServiceUnavailable, 2)
+				},
+				Config: server.ProviderConfig() + testProviderConfigDNSZoneFile,
+			},
+			// errors are returned once retries are exhausted
+			{
+				PreConfig: func() {
+					server.InjectError(http.MethodGet, "dns/zones/1", http.StatusServiceUnavailable, 3)
+				},
+				Config:      server.ProviderConfig() + testProviderConfigDNSZoneFile,
+				ExpectError: regexp.MustCompile(`Unable to get DNS zone`),
+			},
+		},
+	})
+}
+
+func TestProvider_DefaultTenantID(t *testing.T) {
+	t.Setenv("XELON_TENANT_ID", "tenant-123")
+
+	server := newFakeXelonServer(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// omitted tenant_id is set to the provider default
+			{
+				Config: server.ProviderConfig() + testProviderConfigDeviceWithoutTenantID,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectKnownValue("xelon_device.test", tfjsonpath.New("tenant_id"), knownvalue.StringExact("tenant-123")),
+					},
+				},
+				Check: func(_ *terraform.State) error {
+					return testProviderExpectDeviceTenantID(server, "tenant-123")
+				},
+			},
+		},
+	})
+}
+
+func TestProvider_DefaultTenantIDMissing(t *testing.T) {
+	server := newFakeXelonServer(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      server.ProviderConfig() + testProviderConfigDeviceWithoutTenantID,
+				ExpectError: regexp.MustCompile(`"tenant_id" is required if "default_tenant_id" is not set`),
+			},
+		},
+	})
+}
+
+func TestProvider_ReadOnly(t *testing.T) {
+	server := newFakeXelonServer(t)
+	sshKeyPublic := "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIFakeKeyForUnitTestsOnly xelon@unit-test"
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + testAccXelonSSHKeyResource("read-only", sshKeyPublic),
+			},
+			// refresh, data sources, and import keep working
+			{
+				PreConfig: func() {
+					t.Setenv("XELON_READ_ONLY", "true")
+				},
+				Config: server.ProviderConfig() + testAccXelonSSHKeyResource("read-only", sshKeyPublic) + testProviderConfigSSHKeyDataSource,
+				Check:  resource.TestCheckResourceAttr("data.xelon_ssh_key.test", "name", "read-only"),
+			},
+			{
+				ResourceName:      "xelon_ssh_key.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// changes fail before any API request
+			{
+				Config:      server.ProviderConfig() + testAccXelonSSHKeyResource("read-only-updated", sshKeyPublic),
+				ExpectError: regexp.MustCompile(`Xelon provider is read-only`),
+			},
+			// nothing has been changed
+			{
+				PreConfig: func() {
+					t.Setenv("XELON_READ_ONLY", "false")
+				},
+				Config: server.ProviderConfig() + testAccXelonSSHKeyResource("read-only", sshKeyPublic),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func testProviderExpectDeviceTenantID(server *fakeXelonServer, tenantID string) error {
+	var err error
+	server.Mutate(func(s *fakeXelonServer) {
+		for _, device := range s.devices {
+			if device.Tenant == nil || device.Tenant.ID != tenantID {
+				err = fmt.Errorf("expected device %s to belong to tenant %s", device.ID, tenantID)
+			}
+		}
+	})
+	return err
+}
+
+const testProviderConfigDeviceWithoutTenantID = `
+resource "xelon_device" "test" {
+  cpu_core_count = 2
+  disk_size      = 20
+  display_name   = "default-tenant"
+  hostname       = "default-tenant"
+  memory         = 4
+  password       = "Secret-Passw0rd"
+  swap_disk_size = 2
+  template_id    = "template-id"
+
+  networks = [{
+    connected = true
+    id        = "network-id"
+  }]
+}
+`
+
+const testProviderConfigTenant = `
+data "xelon_tenant" "test" {}
+`
+
+const testProviderConfigSSHKeyDataSource = `
+data "xelon_ssh_key" "test" {
+  id = xelon_ssh_key.foobar.id
+}
+`
+
+const testProviderConfigDNSZoneFile = `
+data "xelon_dns_zone_file" "test" {
+  zone_id = "1"
+}
+`
+
 const testProviderConfigWithMissingToken = `
 provider "xelon" {
   token = ""
@@ -5,7 +5,11 @@ import (
 	"errors"
 	"net/http"
 	"slices"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -13,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
@@ -24,37 +29,54 @@ var (
 	_ resource.Resource                = (*deviceResource)(nil)
 	_ resource.ResourceWithConfigure   = (*deviceResource)(nil)
 	_ resource.ResourceWithImportState = (*deviceResource)(nil)
+	_ resource.ResourceWithIdentity    = (*deviceResource)(nil)
 	_ resource.ResourceWithModifyPlan  = (*deviceResource)(nil)
+	_ resourceWithProviderDefaults     = (*deviceResource)(nil)
+)
+
+const (
+	defaultDeviceTimeout = 10 * time.Minute
+
+	devicePowerStateOff = "off"
+	devicePowerStateOn  = "on"
 )
 
 // deviceResource is the device resource implementation.
 type deviceResource struct {
+	withProviderDefaults
+	withReadOnlyMode
+
 	client *xelon.Client
 }
 
 // deviceResourceModel maps the device resource schema data.
 type deviceResourceModel struct {
-	BackupJobID      types.Int64                  `tfsdk:"backup_job_id"`
-	CPUCoreCount     types.Int64                  `tfsdk:"cpu_core_count"`
-	CPUCoreHotPlug   types.Bool                   `tfsdk:"cpu_core_hotplug"`
-	DiskID           types.String                 `tfsdk:"disk_id"`
-	DiskSize         types.Int64                  `tfsdk:"disk_size"`
-	DisplayName      types.String                 `tfsdk:"display_name"`
-	EnableMonitoring types.Bool                   `tfsdk:"enable_monitoring"`
-	Hostname         types.String                 `tfsdk:"hostname"`
-	ID               types.String                 `tfsdk:"id"`
-	Memory           types.Int64                  `tfsdk:"memory"`
-	MemoryHotPlug    types.Bool                   `tfsdk:"memory_hotplug"`
-	Networks         []deviceNetworkResourceModel `tfsdk:"networks"`
-	Password         types.String                 `tfsdk:"password"`
-	SendEmail        types.Bool                   `tfsdk:"send_email"`
-	SSHKeyID         types.String                 `tfsdk:"ssh_key_id"`
-	ScriptID         types.String                 `tfsdk:"script_id"`
-	SwapDiskID       types.String                 `tfsdk:"swap_disk_id"`
-	SwapDiskSize     types.Int64                  `tfsdk:"swap_disk_size"`
-	TemplateID       types.String                 `tfsdk:"template_id"`
-	TenantID         types.String                 `tfsdk:"tenant_id"`
-	UserData         types.String                 `tfsdk:"user_data"`
+	BackupJobID       types.Int64                  `tfsdk:"backup_job_id"`
+	CPUCoreCount      types.Int64                  `tfsdk:"cpu_core_count"`
+	CPUCoreHotPlug    types.Bool                   `tfsdk:"cpu_core_hotplug"`
+	DiskID            types.String                 `tfsdk:"disk_id"`
+	DiskSize          types.Int64                  `tfsdk:"disk_size"`
+	DisplayName       types.String                 `tfsdk:"display_name"`
+	EnableMonitoring  types.Bool                   `tfsdk:"enable_monitoring"`
+	Hostname          types.String                 `tfsdk:"hostname"`
+	ID                types.String                 `tfsdk:"id"`
+	Memory            types.Int64                  `tfsdk:"memory"`
+	MemoryHotPlug     types.Bool                   `tfsdk:"memory_hotplug"`
+	Networks          []deviceNetworkResourceModel `tfsdk:"networks"`
+	Password          types.String                 `tfsdk:"password"`
+	PasswordWO        types.String                 `tfsdk:"password_wo"`
+	PasswordWOVersion types.Int64                  `tfsdk:"password_wo_version"`
+	PowerState        types.String                 `tfsdk:"power_state"`
+	RebootTrigger     types.String                 `tfsdk:"reboot_trigger"`
+	SendEmail         types.Bool                   `tfsdk:"send_email"`
+	SSHKeyID          types.String                 `tfsdk:"ssh_key_id"`
+	ScriptID          types.String                 `tfsdk:"script_id"`
+	SwapDiskID        types.String                 `tfsdk:"swap_disk_id"`
+	SwapDiskSize      types.Int64                  `tfsdk:"swap_disk_size"`
+	TemplateID        types.String                 `tfsdk:"template_id"`
+	TenantID          types.String                 `tfsdk:"tenant_id"`
+	Timeouts          timeouts.Value               `tfsdk:"timeouts"`
+	UserData          types.String                 `tfsdk:"user_data"`
 }
 
 type deviceNetworkResourceModel struct {
@@ -72,7 +94,7 @@ func (r *deviceResource) Metadata(_ context.Context, _ resource.MetadataRequest,
 	response.TypeName = "xelon_device"
 }
 
-func (r *deviceResource) Schema(_ context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+func (r *deviceResource) Schema(ctx context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
 	response.Schema = schema.Schema{
 		MarkdownDescription: `
 The device resource allows you to manage Xelon devices.
@@ -182,13 +204,52 @@ Devices are the virtual machines that run your applications.
 				Required: true,
 			},
 			"password": schema.StringAttribute{
-				MarkdownDescription: "The password for the device root or administrator user. Required if `user_data` is empty.",
+				MarkdownDescription: "The password for the device root or administrator user. Required if `user_data` and `password_wo` are empty.",
 				Optional:            true,
 				Sensitive:           true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplaceIfConfigured(),
 				},
 			},
+			"password_wo": schema.StringAttribute{
+				MarkdownDescription: "The password for the device root or administrator user, which is not stored in the plan or state. " +
+					"Requires Terraform 1.11 or later. Required if `user_data` and `password` are empty. " +
+					"Increment `password_wo_version` to replace the device with a new password.",
+				Optional:  true,
+				Sensitive: true,
+				WriteOnly: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("password")),
+					stringvalidator.AlsoRequires(path.MatchRoot("password_wo_version")),
+				},
+			},
+			"password_wo_version": schema.Int64Attribute{
+				MarkdownDescription: "The version of `password_wo`. Updates to this field will force a new resource to be created.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplaceIfConfigured(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("password_wo")),
+				},
+			},
+			"power_state": schema.StringAttribute{
+				MarkdownDescription: "The desired power state of the device. Must be one of `on` or `off`. " +
+					"If omitted, the power state is not managed and reflects the current state of the device.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf([]string{devicePowerStateOn, devicePowerStateOff}...),
+				},
+			},
+			"reboot_trigger": schema.StringAttribute{
+				MarkdownDescription: "An arbitrary value, changing it reboots the device, e.g. a timestamp or a hash of the files " +
+					"requiring a reboot. The device is only rebooted if it is powered on and `power_state` is not `off`.",
+				Optional: true,
+			},
 			"send_email": schema.BoolAttribute{
 				MarkdownDescription: "Whether to send an email notification upon successful device creation.",
 				Optional:            true,
@@ -226,16 +287,35 @@ Devices are the virtual machines that run your applications.
 				},
 			},
 			"template_id": schema.StringAttribute{
-				MarkdownDescription: "The template ID used to create the device.",
-				Required:            true,
+				MarkdownDescription: "The template ID used to create the device. " +
+					"The Xelon API does not return the template, so an imported device adopts the configured value without being replaced.",
+				Required: true,
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.RequiresReplaceIf(
+						func(_ context.Context, request planmodifier.StringRequest, response *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+							response.RequiresReplace = !request.StateValue.IsNull()
+						},
+						"Changing the template of a created device requires replacement.",
+						"Changing the template of a created device requires replacement.",
+					),
 				},
 			},
 			"tenant_id": schema.StringAttribute{
-				MarkdownDescription: "The tenant ID to whom the device belongs.",
-				Required:            true,
+				MarkdownDescription: "The tenant ID to whom the device belongs. Defaults to the provider `default_tenant_id`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					r.tenantIDPlanModifier(true),
+				},
 			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create:            true,
+				CreateDescription: "Applies to each step waiting for the resource. Defaults to 10m.",
+				Update:            true,
+				UpdateDescription: "Applies to each step waiting for the resource. Defaults to 10m.",
+				Delete:            true,
+				DeleteDescription: "Applies to each step waiting for the resource. Defaults to 10m.",
+			}),
 			"user_data": schema.StringAttribute{
 				MarkdownDescription: "User data to provide when launching the device. Updates to this field will force a new resource to be created.",
 				Optional:            true,
@@ -247,6 +327,10 @@ Devices are the virtual machines that run your applications.
 	}
 }
 
+func (r *deviceResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, response *resource.IdentitySchemaResponse) {
+	response.IdentitySchema = idIdentitySchema("The ID of the device.")
+}
+
 func (r *deviceResource) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
 	if request.ProviderData == nil {
 		return
@@ -265,6 +349,10 @@ func (r *deviceResource) Configure(_ context.Context, request resource.Configure
 }
 
 func (r *deviceResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	if r.checkReadOnly("create", &response.Diagnostics) {
+		return
+	}
+
 	var data deviceResourceModel
 
 	// read plan data into the model
@@ -274,6 +362,13 @@ func (r *deviceResource) Create(ctx context.Context, request resource.CreateRequ
 		return
 	}
 
+	// configure timeout
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultDeviceTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	var networks []xelon.DeviceCreateNetwork
 	for _, network := range data.Networks {
 		n := xelon.DeviceCreateNetwork{
@@ -288,14 +383,24 @@ func (r *deviceResource) Create(ctx context.Context, request resource.CreateRequ
 		}
 		networks = append(networks, n)
 	}
+	password := data.Password.ValueString()
+	if password == "" {
+		// write-only values are only available in the config
+		var passwordWO types.String
+		response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root("password_wo"), &passwordWO)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+		password = passwordWO.ValueString()
+	}
 	createRequest := &xelon.DeviceCreateRequest{
 		CPUCores:             int(data.CPUCoreCount.ValueInt64()),
 		DiskSize:             int(data.DiskSize.ValueInt64()),
 		DisplayName:          data.DisplayName.ValueString(),
 		HostName:             data.Hostname.ValueString(),
 		Networks:             networks,
-		Password:             data.Password.ValueString(),
-		PasswordConfirmation: data.Password.ValueString(),
+		Password:             password,
+		PasswordConfirmation: password,
 		RAM:                  int(data.Memory.ValueInt64()),
 		SwapDiskSize:         int(data.SwapDiskSize.ValueInt64()),
 		TemplateID:           data.TemplateID.ValueString(),
@@ -328,7 +433,7 @@ func (r *deviceResource) Create(ctx context.Context, request resource.CreateRequ
 	deviceID := createdDevice.ID
 
 	tflog.Info(ctx, "waiting for device to be powered on", map[string]any{"device_id": deviceID})
-	err = helper.WaitDevicePowerStateOn(ctx, r.client, deviceID)
+	err = helper.WaitDevicePowerStateOn(ctx, r.client, deviceID, createTimeout)
 	if err != nil {
 		response.Diagnostics.AddError("Unable to wait for device to be powered on", err.Error())
 		return
@@ -336,13 +441,21 @@ func (r *deviceResource) Create(ctx context.Context, request resource.CreateRequ
 	tflog.Info(ctx, "device is powered on", map[string]any{"device_id": deviceID})
 
 	tflog.Info(ctx, "waiting for device to be ready", map[string]any{"device_id": deviceID})
-	err = helper.WaitDeviceStateReady(ctx, r.client, deviceID)
+	err = helper.WaitDeviceStateReady(ctx, r.client, deviceID, createTimeout)
 	if err != nil {
 		response.Diagnostics.AddError("Unable to wait for device to be ready", err.Error())
 		return
 	}
 	tflog.Info(ctx, "device is ready", map[string]any{"device_id": deviceID})
 
+	if data.PowerState.ValueString() == devicePowerStateOff {
+		err = r.updatePowerState(ctx, deviceID, devicePowerStateOff, createTimeout)
+		if err != nil {
+			response.Diagnostics.AddError("Unable to update device power state", err.Error())
+			return
+		}
+	}
+
 	_, err = r.refreshDeviceState(ctx, &data, deviceID)
 	if err != nil {
 		response.Diagnostics.AddError("Unable to refresh device state", err.Error())
@@ -351,6 +464,7 @@ func (r *deviceResource) Create(ctx context.Context, request resource.CreateRequ
 
 	diags = response.State.Set(ctx, &data)
 	response.Diagnostics.Append(diags...)
+	setResourceIdentity(ctx, response.Identity, idResourceIdentityModel{ID: data.ID}, &response.Diagnostics)
 }
 
 func (r *deviceResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
@@ -377,9 +491,14 @@ func (r *deviceResource) Read(ctx context.Context, request resource.ReadRequest,
 
 	diags = response.State.Set(ctx, &data)
 	response.Diagnostics.Append(diags...)
+	setResourceIdentity(ctx, response.Identity, idResourceIdentityModel{ID: data.ID}, &response.Diagnostics)
 }
 
 func (r *deviceResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	if r.checkReadOnly("update", &response.Diagnostics) {
+		return
+	}
+
 	var plan, state deviceResourceModel
 
 	// read plan and state data into the model
@@ -389,6 +508,13 @@ func (r *deviceResource) Update(ctx context.Context, request resource.UpdateRequ
 		return
 	}
 
+	// configure timeout
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultDeviceTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	deviceID := state.ID.ValueString()
 
 	if !plan.DisplayName.Equal(state.DisplayName) {
@@ -407,7 +533,7 @@ func (r *deviceResource) Update(ctx context.Context, request resource.UpdateRequ
 	}
 
 	if !plan.DiskSize.Equal(state.DiskSize) {
-		err := deleteSnapshotsIfNeeded(ctx, r.client, deviceID)
+		err := deleteSnapshotsIfNeeded(ctx, r.client, deviceID, updateTimeout)
 		if err != nil {
 			response.Diagnostics.AddError("Unable to delete device disk snapshots", err.Error())
 			return
@@ -430,7 +556,7 @@ func (r *deviceResource) Update(ctx context.Context, request resource.UpdateRequ
 		tflog.Debug(ctx, "updated disk size", map[string]any{"device_id": deviceID, "data": device})
 
 		tflog.Info(ctx, "waiting for disk size to be updated after extension")
-		err = helper.WaitDeviceStateReady(ctx, r.client, deviceID)
+		err = helper.WaitDeviceStateReady(ctx, r.client, deviceID, updateTimeout)
 		if err != nil {
 			response.Diagnostics.AddError("Unable to wait for disk size to be updated", err.Error())
 			return
@@ -439,7 +565,7 @@ func (r *deviceResource) Update(ctx context.Context, request resource.UpdateRequ
 	}
 
 	if !plan.SwapDiskSize.Equal(state.SwapDiskSize) {
-		err := deleteSnapshotsIfNeeded(ctx, r.client, deviceID)
+		err := deleteSnapshotsIfNeeded(ctx, r.client, deviceID, updateTimeout)
 		if err != nil {
 			response.Diagnostics.AddError("Unable to delete device disk snapshots", err.Error())
 			return
@@ -462,7 +588,7 @@ func (r *deviceResource) Update(ctx context.Context, request resource.UpdateRequ
 		tflog.Debug(ctx, "updated swap disk size", map[string]any{"device_id": deviceID, "data": device})
 
 		tflog.Info(ctx, "waiting for swap disk size to be updated after extension")
-		err = helper.WaitDeviceStateReady(ctx, r.client, deviceID)
+		err = helper.WaitDeviceStateReady(ctx, r.client, deviceID, updateTimeout)
 		if err != nil {
 			response.Diagnostics.AddError("Unable to wait for swap disk size to be updated", err.Error())
 			return
@@ -489,7 +615,7 @@ func (r *deviceResource) Update(ctx context.Context, request resource.UpdateRequ
 					return
 				}
 
-				err = helper.WaitDevicePowerStateOff(ctx, r.client, deviceID)
+				err = helper.WaitDevicePowerStateOff(ctx, r.client, deviceID, updateTimeout)
 				if err != nil {
 					response.Diagnostics.AddError("Unable to wait for device to be powered off", err.Error())
 					return
@@ -510,7 +636,7 @@ func (r *deviceResource) Update(ctx context.Context, request resource.UpdateRequ
 		tflog.Debug(ctx, "updated device hardware", map[string]any{"data": updatedDevice})
 
 		// device must be started after changing CUP count and RAM if hotplug is false
-		if deviceMustBeRestarted {
+		if deviceMustBeRestarted && plan.PowerState.ValueString() != devicePowerStateOff {
 			tflog.Debug(ctx, "getting device", map[string]any{"device_id": deviceID})
 			device, _, err := r.client.Devices.Get(ctx, deviceID)
 			if err != nil {
@@ -526,7 +652,7 @@ func (r *deviceResource) Update(ctx context.Context, request resource.UpdateRequ
 					return
 				}
 
-				err = helper.WaitDevicePowerStateOn(ctx, r.client, deviceID)
+				err = helper.WaitDevicePowerStateOn(ctx, r.client, deviceID, updateTimeout)
 				if err != nil {
 					response.Diagnostics.AddError("Unable to wait for device to be powered on", err.Error())
 					return
@@ -536,7 +662,7 @@ func (r *deviceResource) Update(ctx context.Context, request resource.UpdateRequ
 
 		// ensure device is in ready state
 		tflog.Info(ctx, "waiting for device to be ready", map[string]any{"device_id": deviceID})
-		err = helper.WaitDeviceStateReady(ctx, r.client, deviceID)
+		err = helper.WaitDeviceStateReady(ctx, r.client, deviceID, updateTimeout)
 		if err != nil {
 			response.Diagnostics.AddError("Unable to wait for device to be ready", err.Error())
 			return
@@ -544,17 +670,38 @@ func (r *deviceResource) Update(ctx context.Context, request resource.UpdateRequ
 		tflog.Info(ctx, "device is ready", map[string]any{"device_id": deviceID})
 	}
 
+	if !plan.RebootTrigger.Equal(state.RebootTrigger) && !plan.RebootTrigger.IsNull() && plan.PowerState.ValueString() != devicePowerStateOff {
+		err := r.rebootDevice(ctx, deviceID, updateTimeout)
+		if err != nil {
+			response.Diagnostics.AddError("Unable to reboot device", err.Error())
+			return
+		}
+	}
+
+	if !plan.PowerState.IsUnknown() && !plan.PowerState.IsNull() {
+		err := r.updatePowerState(ctx, deviceID, plan.PowerState.ValueString(), updateTimeout)
+		if err != nil {
+			response.Diagnostics.AddError("Unable to update device power state", err.Error())
+			return
+		}
+	}
+
 	_, err := r.refreshDeviceState(ctx, &plan, deviceID)
 	if err != nil {
 		response.Diagnostics.AddError("Unable to refresh device state", err.Error())
 		return
 	}
 
-	diags := response.State.Set(ctx, &plan)
+	diags = response.State.Set(ctx, &plan)
 	response.Diagnostics.Append(diags...)
+	setResourceIdentity(ctx, response.Identity, idResourceIdentityModel{ID: plan.ID}, &response.Diagnostics)
 }
 
 func (r *deviceResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	if r.checkReadOnly("delete", &response.Diagnostics) {
+		return
+	}
+
 	var data deviceResourceModel
 
 	// read state data into the model
@@ -564,6 +711,13 @@ func (r *deviceResource) Delete(ctx context.Context, request resource.DeleteRequ
 		return
 	}
 
+	// configure timeout
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultDeviceTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	deviceID := data.ID.ValueString()
 	tflog.Debug(ctx, "getting device", map[string]any{"device_id": deviceID})
 	device, resp, err := r.client.Devices.Get(ctx, deviceID)
@@ -584,7 +738,7 @@ func (r *deviceResource) Delete(ctx context.Context, request resource.DeleteRequ
 			return
 		}
 
-		err = helper.WaitDevicePowerStateOff(ctx, r.client, deviceID)
+		err = helper.WaitDevicePowerStateOff(ctx, r.client, deviceID, deleteTimeout)
 		if err != nil {
 			response.Diagnostics.AddError("Unable to wait for device to be powered off", err.Error())
 			return
@@ -601,7 +755,7 @@ func (r *deviceResource) Delete(ctx context.Context, request resource.DeleteRequ
 }
 
 func (r *deviceResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), request, response)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), request, response)
 }
 
 func (r *deviceResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
@@ -616,6 +770,15 @@ func (r *deviceResource) ModifyPlan(ctx context.Context, request resource.Modify
 		return
 	}
 
+	// write-only values are only available in the config
+	passwordWO := types.StringNull()
+	if !request.Config.Raw.IsNull() {
+		response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root("password_wo"), &passwordWO)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	requiresCreateInputs := request.State.Raw.IsNull()
 	if !request.State.Raw.IsNull() {
 		var state deviceResourceModel
@@ -625,7 +788,8 @@ func (r *deviceResource) ModifyPlan(ctx context.Context, request resource.Modify
 		}
 
 		requiresCreateInputs = !plan.Password.Equal(state.Password) ||
-			!plan.TemplateID.Equal(state.TemplateID) ||
+			!plan.PasswordWOVersion.Equal(state.PasswordWOVersion) ||
+			!state.TemplateID.IsNull() && !plan.TemplateID.Equal(state.TemplateID) ||
 			!plan.UserData.Equal(state.UserData)
 	}
 	if !requiresCreateInputs {
@@ -635,14 +799,14 @@ func (r *deviceResource) ModifyPlan(ctx context.Context, request resource.Modify
 	if plan.UserData.IsUnknown() || plan.UserData.ValueString() != "" {
 		return
 	}
-	if plan.Password.IsUnknown() || plan.SwapDiskSize.IsUnknown() {
+	if plan.Password.IsUnknown() || passwordWO.IsUnknown() || plan.SwapDiskSize.IsUnknown() {
 		return
 	}
-	if plan.Password.ValueString() == "" {
+	if plan.Password.ValueString() == "" && passwordWO.ValueString() == "" {
 		response.Diagnostics.AddAttributeError(
 			path.Root("password"),
 			"Missing password or user_data",
-			`Either "password" or "user_data" must be specified when creating or replacing a device.`,
+			`Either "password", "password_wo", or "user_data" must be specified when creating or replacing a device.`,
 		)
 	}
 	if plan.SwapDiskSize.IsNull() {
@@ -655,6 +819,22 @@ func (r *deviceResource) ModifyPlan(ctx context.Context, request resource.Modify
 }
 
 func (m *deviceResourceModel) fromAPI(ctx context.Context, device *xelon.Device, deviceNetworks []xelon.DeviceNetwork) {
+	// an imported device has no disk size and networks in the state yet
+	if m.DiskSize.IsNull() && len(device.Storages) > 0 {
+		primaryStorage := slices.MinFunc(device.Storages, func(first, second xelon.DeviceStorage) int {
+			return first.UnitNumber - second.UnitNumber
+		})
+		m.DiskSize = types.Int64Value(int64(primaryStorage.Size))
+	}
+	if m.Networks == nil {
+		for _, deviceNetwork := range deviceNetworks {
+			m.Networks = append(m.Networks, deviceNetworkResourceModel{
+				Connected:   types.BoolValue(deviceNetwork.Connected),
+				ID:          types.StringValue(deviceNetwork.ID),
+				IPAddressID: types.StringNull(),
+			})
+		}
+	}
 	primaryDisk := findDiskIDBySize(ctx, int(m.DiskSize.ValueInt64()), device.Storages)
 	swapDisk := findDiskIDBySize(ctx, int(m.SwapDiskSize.ValueInt64()), device.Storages)
 
@@ -673,6 +853,68 @@ func (m *deviceResourceModel) fromAPI(ctx context.Context, device *xelon.Device,
 	m.Memory = types.Int64Value(int64(device.RAM))
 	m.MemoryHotPlug = types.BoolValue(device.RAMHotAddEnabled)
 	m.Networks = populateDeviceNetworkIPv4Addresses(m.Networks, deviceNetworks)
+	m.PowerState = types.StringValue(devicePowerState(device))
+	if device.Tenant != nil {
+		m.TenantID = types.StringValue(device.Tenant.ID)
+	}
+}
+
+func devicePowerState(device *xelon.Device) string {
+	if device.PoweredOn {
+		return devicePowerStateOn
+	}
+	return devicePowerStateOff
+}
+
+// updatePowerState starts or stops the device if its current power state
+// differs from the desired one and waits until the transition is finished.
+func (r *deviceResource) updatePowerState(ctx context.Context, deviceID, powerState string, timeout time.Duration) error {
+	tflog.Debug(ctx, "getting device", map[string]any{"device_id": deviceID})
+	device, _, err := r.client.Devices.Get(ctx, deviceID)
+	if err != nil {
+		return err
+	}
+	tflog.Debug(ctx, "got device", map[string]any{"data": device})
+
+	switch {
+	case powerState == devicePowerStateOn && !device.PoweredOn:
+		tflog.Info(ctx, "starting device", map[string]any{"device_id": deviceID})
+		_, err := r.client.Devices.Start(ctx, deviceID)
+		if err != nil {
+			return err
+		}
+		return helper.WaitDevicePowerStateOn(ctx, r.client, deviceID, timeout)
+	case powerState == devicePowerStateOff && device.PoweredOn:
+		tflog.Info(ctx, "stopping device", map[string]any{"device_id": deviceID})
+		_, err := r.client.Devices.Stop(ctx, deviceID)
+		if err != nil {
+			return err
+		}
+		return helper.WaitDevicePowerStateOff(ctx, r.client, deviceID, timeout)
+	}
+
+	return nil
+}
+
+// rebootDevice stops and starts the device if it is powered on and waits
+// until it is powered on again.
+func (r *deviceResource) rebootDevice(ctx context.Context, deviceID string, timeout time.Duration) error {
+	tflog.Debug(ctx, "getting device", map[string]any{"device_id": deviceID})
+	device, _, err := r.client.Devices.Get(ctx, deviceID)
+	if err != nil {
+		return err
+	}
+	tflog.Debug(ctx, "got device", map[string]any{"data": device})
+	if !device.PoweredOn {
+		tflog.Info(ctx, "skipping reboot of powered off device", map[string]any{"device_id": deviceID})
+		return nil
+	}
+
+	tflog.Info(ctx, "rebooting device", map[string]any{"device_id": deviceID})
+	if err := r.updatePowerState(ctx, deviceID, devicePowerStateOff, timeout); err != nil {
+		return err
+	}
+	return r.updatePowerState(ctx, deviceID, devicePowerStateOn, timeout)
 }
 
 func (r *deviceResource) refreshDeviceState(ctx context.Context, model *deviceResourceModel, deviceID string) (*xelon.Response, error) {
@@ -748,7 +990,7 @@ func findDiskIDBySize(ctx context.Context, diskSize int, storages []xelon.Device
 	return storagesMatchedBySize[0]
 }
 
-func deleteSnapshotsIfNeeded(ctx context.Context, client *xelon.Client, deviceID string) error {
+func deleteSnapshotsIfNeeded(ctx context.Context, client *xelon.Client, deviceID string, timeout time.Duration) error {
 	snapshots, _, err := client.Snapshots.List(ctx, deviceID, nil)
 	if err != nil {
 		return err
@@ -777,7 +1019,7 @@ func deleteSnapshotsIfNeeded(ctx context.Context, client *xelon.Client, deviceID
 		})
 	}
 
-	err = helper.WaitDeviceSnapshotsDeleted(ctx, client, deviceID)
+	err = helper.WaitDeviceSnapshotsDeleted(ctx, client, deviceID, timeout)
 	if err != nil {
 		errs = append(errs, err)
 	}
@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -22,10 +23,13 @@ var (
 	_ resource.Resource                = (*deviceBackupResource)(nil)
 	_ resource.ResourceWithConfigure   = (*deviceBackupResource)(nil)
 	_ resource.ResourceWithImportState = (*deviceBackupResource)(nil)
+	_ resource.ResourceWithIdentity    = (*deviceBackupResource)(nil)
 )
 
 // deviceBackupResource is the device backup resource implementation.
 type deviceBackupResource struct {
+	withReadOnlyMode
+
 	client *xelon.Client
 }
 
@@ -35,6 +39,11 @@ type deviceBackupResourceModel struct {
 	DeviceID     types.String `tfsdk:"device_id"`
 }
 
+// deviceBackupResourceIdentityModel maps the device backup resource identity schema data.
+type deviceBackupResourceIdentityModel struct {
+	DeviceID types.String `tfsdk:"device_id"`
+}
+
 func NewDeviceBackupResource() resource.Resource {
 	return &deviceBackupResource{}
 }
@@ -73,6 +82,17 @@ Deleting this resource disables backups for the device by removing its backup pl
 	}
 }
 
+func (r *deviceBackupResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, response *resource.IdentitySchemaResponse) {
+	response.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"device_id": identityschema.StringAttribute{
+				Description:       "ID of the device whose backup plan assignment is managed.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *deviceBackupResource) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
 	if request.ProviderData == nil {
 		return
@@ -91,6 +111,10 @@ func (r *deviceBackupResource) Configure(_ context.Context, request resource.Con
 }
 
 func (r *deviceBackupResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	if r.checkReadOnly("create", &response.Diagnostics) {
+		return
+	}
+
 	var data deviceBackupResourceModel
 
 	diags := request.Plan.Get(ctx, &data)
@@ -146,6 +170,7 @@ func (r *deviceBackupResource) Create(ctx context.Context, request resource.Crea
 
 	diags = response.State.Set(ctx, &data)
 	response.Diagnostics.Append(diags...)
+	setResourceIdentity(ctx, response.Identity, deviceBackupResourceIdentityModel{DeviceID: data.DeviceID}, &response.Diagnostics)
 }
 
 func (r *deviceBackupResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
@@ -184,9 +209,14 @@ func (r *deviceBackupResource) Read(ctx context.Context, request resource.ReadRe
 
 	diags = response.State.Set(ctx, &data)
 	response.Diagnostics.Append(diags...)
+	setResourceIdentity(ctx, response.Identity, deviceBackupResourceIdentityModel{DeviceID: data.DeviceID}, &response.Diagnostics)
 }
 
 func (r *deviceBackupResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	if r.checkReadOnly("update", &response.Diagnostics) {
+		return
+	}
+
 	var data deviceBackupResourceModel
 
 	diags := request.Plan.Get(ctx, &data)
@@ -242,9 +272,14 @@ func (r *deviceBackupResource) Update(ctx context.Context, request resource.Upda
 
 	diags = response.State.Set(ctx, &data)
 	response.Diagnostics.Append(diags...)
+	setResourceIdentity(ctx, response.Identity, deviceBackupResourceIdentityModel{DeviceID: data.DeviceID}, &response.Diagnostics)
 }
 
 func (r *deviceBackupResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	if r.checkReadOnly("delete", &response.Diagnostics) {
+		return
+	}
+
 	var data deviceBackupResourceModel
 
 	diags := request.State.Get(ctx, &data)
@@ -272,7 +307,7 @@ func (r *deviceBackupResource) Delete(ctx context.Context, request resource.Dele
 }
 
 func (r *deviceBackupResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
-	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("device_id"), request.ID)...)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("device_id"), path.Root("device_id"), request, response)
 }
 
 func (m *deviceBackupResourceModel) fromAPI(backupPlan *xelon.BackupPlan, deviceID string) {
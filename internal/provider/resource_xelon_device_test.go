@@ -2,17 +2,26 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"net/netip"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
-	"github.com/hashicorp/terraform-plugin-framework/resource"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -55,8 +64,8 @@ func TestResourceXelonDevice_Create_RequiresPasswordOrUserData(t *testing.T) {
 		Raw:    tftypes.NewValue(deviceSchema.Type().TerraformType(ctx), nil),
 	}
 
-	response := &resource.ModifyPlanResponse{}
-	NewDeviceResource().(*deviceResource).ModifyPlan(ctx, resource.ModifyPlanRequest{
+	response := &fwresource.ModifyPlanResponse{}
+	NewDeviceResource().(*deviceResource).ModifyPlan(ctx, fwresource.ModifyPlanRequest{
 		Plan:  plan,
 		State: state,
 	}, response)
@@ -65,6 +74,42 @@ func TestResourceXelonDevice_Create_RequiresPasswordOrUserData(t *testing.T) {
 	assert.True(t, response.Diagnostics.Equal(expectedDeviceMissingPasswordOrUserDataDiagnostics()))
 }
 
+func TestResourceXelonDevice_Create_AcceptsWriteOnlyPassword(t *testing.T) {
+	ctx := context.Background()
+	deviceSchema := testDeviceResourceSchema(t)
+	plan := testDeviceResourcePlan(t, ctx, deviceSchema, types.StringNull(), types.StringNull())
+	configPlan := testDeviceResourcePlan(t, ctx, deviceSchema, types.StringNull(), types.StringNull())
+	require.False(t, configPlan.SetAttribute(ctx, path.Root("password_wo"), "write-only-password").HasError())
+	require.False(t, configPlan.SetAttribute(ctx, path.Root("password_wo_version"), 1).HasError())
+	state := tfsdk.State{
+		Schema: deviceSchema,
+		Raw:    tftypes.NewValue(deviceSchema.Type().TerraformType(ctx), nil),
+	}
+
+	response := &fwresource.ModifyPlanResponse{}
+	NewDeviceResource().(*deviceResource).ModifyPlan(ctx, fwresource.ModifyPlanRequest{
+		Config: tfsdk.Config{Schema: deviceSchema, Raw: configPlan.Raw},
+		Plan:   plan,
+		State:  state,
+	}, response)
+
+	assert.False(t, response.Diagnostics.HasError())
+}
+
+func TestResourceXelonDevice_Schema_PasswordWO(t *testing.T) {
+	deviceSchema := testDeviceResourceSchema(t)
+
+	passwordWO, ok := deviceSchema.Attributes["password_wo"].(schema.StringAttribute)
+	require.True(t, ok)
+	assert.True(t, passwordWO.WriteOnly)
+	assert.True(t, passwordWO.Sensitive)
+
+	passwordWOVersion, ok := deviceSchema.Attributes["password_wo_version"].(schema.Int64Attribute)
+	require.True(t, ok)
+	require.Len(t, passwordWOVersion.PlanModifiers, 1)
+	assert.Contains(t, passwordWOVersion.PlanModifiers[0].Description(context.Background()), "destroy and recreate")
+}
+
 func TestResourceXelonDevice_Import_DoesNotRequirePasswordOrUserData(t *testing.T) {
 	ctx := context.Background()
 	deviceSchema := testDeviceResourceSchema(t)
@@ -74,8 +119,8 @@ func TestResourceXelonDevice_Import_DoesNotRequirePasswordOrUserData(t *testing.
 		Raw:    plan.Raw,
 	}
 
-	response := &resource.ModifyPlanResponse{}
-	NewDeviceResource().(*deviceResource).ModifyPlan(ctx, resource.ModifyPlanRequest{
+	response := &fwresource.ModifyPlanResponse{}
+	NewDeviceResource().(*deviceResource).ModifyPlan(ctx, fwresource.ModifyPlanRequest{
 		Plan:  plan,
 		State: state,
 	}, response)
@@ -114,8 +159,8 @@ func TestResourceXelonDevice_Replacement_RequiresPasswordOrUserData(t *testing.T
 		Raw:    statePlan.Raw,
 	}
 
-	response := &resource.ModifyPlanResponse{}
-	NewDeviceResource().(*deviceResource).ModifyPlan(ctx, resource.ModifyPlanRequest{
+	response := &fwresource.ModifyPlanResponse{}
+	NewDeviceResource().(*deviceResource).ModifyPlan(ctx, fwresource.ModifyPlanRequest{
 		Plan:  plan,
 		State: state,
 	}, response)
@@ -270,6 +315,7 @@ func TestResourceXelonDevice_Model_FromAPI_PreservesCreateTimeOnlyFields(t *test
 			testDeviceNetworkResourceModel("network-id", types.StringValue("10.0.0.25")),
 		},
 		Password:     types.StringValue("password"),
+		PowerState:   types.StringValue("off"),
 		SendEmail:    types.BoolValue(true),
 		SSHKeyID:     types.StringValue("ssh-key-id"),
 		ScriptID:     types.StringValue("script-id"),
@@ -326,12 +372,315 @@ func TestResourceXelonDevice_Model_FromAPI_PopulatesComputedNetworkIPv4(t *testi
 	assert.Equal(t, expected, actual.Networks)
 }
 
+func TestResourceXelonDevice_Model_FromAPI_Import(t *testing.T) {
+	device := &xelon.Device{
+		ID: "device-id",
+		Storages: []xelon.DeviceStorage{
+			{ID: "swap-disk-id", Size: 2, UnitNumber: 1},
+			{ID: "disk-id", Size: 20, UnitNumber: 0},
+		},
+		Tenant: &xelon.Tenant{ID: "tenant-id"},
+	}
+	actual := deviceResourceModel{ID: types.StringValue("device-id")}
+
+	actual.fromAPI(context.Background(), device, []xelon.DeviceNetwork{
+		testDeviceNetworkInfo("network-1", true, "10.0.0.25"),
+		testDeviceNetworkInfo("network-2", true),
+	})
+
+	assert.Equal(t, types.StringValue("disk-id"), actual.DiskID)
+	assert.Equal(t, types.Int64Value(20), actual.DiskSize)
+	assert.Equal(t, []deviceNetworkResourceModel{
+		testDeviceNetworkResourceModel("network-1", types.StringValue("10.0.0.25")),
+		testDeviceNetworkResourceModel("network-2", types.StringNull()),
+	}, actual.Networks)
+	assert.True(t, actual.TemplateID.IsNull())
+	assert.Equal(t, types.StringValue("tenant-id"), actual.TenantID)
+}
+
+func TestResourceXelonDevice_Model_FromAPI_PowerState(t *testing.T) {
+	var actual deviceResourceModel
+
+	actual.fromAPI(context.Background(), &xelon.Device{PoweredOn: true}, nil)
+	assert.Equal(t, types.StringValue("on"), actual.PowerState)
+
+	actual.fromAPI(context.Background(), &xelon.Device{PoweredOn: false}, nil)
+	assert.Equal(t, types.StringValue("off"), actual.PowerState)
+}
+
+func TestResourceXelonDevice_Lifecycle(t *testing.T) {
+	server := newFakeXelonServer(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// create and read
+			{
+				Config: server.ProviderConfig() + testDeviceLifecycleConfig("lifecycle", 20),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"xelon_device.test",
+						tfjsonpath.New("disk_id"),
+						knownvalue.NotNull(),
+					),
+					statecheck.ExpectKnownValue(
+						"xelon_device.test",
+						tfjsonpath.New("disk_size"),
+						knownvalue.Int64Exact(20),
+					),
+					statecheck.ExpectKnownValue(
+						"xelon_device.test",
+						tfjsonpath.New("display_name"),
+						knownvalue.StringExact("lifecycle"),
+					),
+					statecheck.ExpectKnownValue(
+						"xelon_device.test",
+						tfjsonpath.New("id"),
+						knownvalue.NotNull(),
+					),
+					statecheck.ExpectKnownValue(
+						"xelon_device.test",
+						tfjsonpath.New("swap_disk_id"),
+						knownvalue.NotNull(),
+					),
+				},
+			},
+			// update and read
+			{
+				Config: server.ProviderConfig() + testDeviceLifecycleConfig("lifecycle-updated", 30),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("xelon_device.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"xelon_device.test",
+						tfjsonpath.New("disk_size"),
+						knownvalue.Int64Exact(30),
+					),
+					statecheck.ExpectKnownValue(
+						"xelon_device.test",
+						tfjsonpath.New("display_name"),
+						knownvalue.StringExact("lifecycle-updated"),
+					),
+				},
+			},
+			// device deleted outside of terraform is re-created
+			{
+				PreConfig: func() {
+					server.Mutate(func(s *fakeXelonServer) {
+						clear(s.devices)
+					})
+				},
+				Config: server.ProviderConfig() + testDeviceLifecycleConfig("lifecycle-updated", 30),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("xelon_device.test", plancheck.ResourceActionCreate),
+					},
+				},
+			},
+		},
+	})
+}
+
+func testDeviceLifecycleConfig(displayName string, diskSize int) string {
+	return fmt.Sprintf(`
+resource "xelon_device" "test" {
+  cpu_core_count = 2
+  disk_size      = %[2]d
+  display_name   = %[1]q
+  hostname       = "lifecycle"
+  memory         = 4
+  password       = "Secret-Passw0rd"
+  swap_disk_size = 2
+  template_id    = "template-id"
+  tenant_id      = "tenant-123"
+
+  networks = [{
+    connected = true
+    id        = "network-id"
+  }]
+}
+`, displayName, diskSize)
+}
+
+func TestResourceXelonDevice_PowerState(t *testing.T) {
+	server := newFakeXelonServer(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// create powered off device
+			{
+				Config: server.ProviderConfig() + testDevicePowerStateConfig("off"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("xelon_device.test", "power_state", "off"),
+					func(_ *terraform.State) error {
+						return testDeviceExpectPoweredOn(server, false)
+					},
+				),
+			},
+			// power on device
+			{
+				Config: server.ProviderConfig() + testDevicePowerStateConfig("on"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("xelon_device.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("xelon_device.test", "power_state", "on"),
+					func(_ *terraform.State) error {
+						return testDeviceExpectPoweredOn(server, true)
+					},
+				),
+			},
+			// device powered off outside of terraform is detected and powered on again
+			{
+				PreConfig: func() {
+					server.Mutate(func(s *fakeXelonServer) {
+						for _, device := range s.devices {
+							device.PoweredOn = false
+						}
+					})
+				},
+				Config: server.ProviderConfig() + testDevicePowerStateConfig("on"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("xelon_device.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: func(_ *terraform.State) error {
+					return testDeviceExpectPoweredOn(server, true)
+				},
+			},
+		},
+	})
+}
+
+func testDeviceExpectPoweredOn(server *fakeXelonServer, poweredOn bool) error {
+	var err error
+	server.Mutate(func(s *fakeXelonServer) {
+		for _, device := range s.devices {
+			if device.PoweredOn != poweredOn {
+				err = fmt.Errorf("expected device %s to have powered on %t", device.ID, poweredOn)
+			}
+		}
+	})
+	return err
+}
+
+func testDevicePowerStateConfig(powerState string) string {
+	return fmt.Sprintf(`
+resource "xelon_device" "test" {
+  cpu_core_count = 2
+  disk_size      = 20
+  display_name   = "power-state"
+  hostname       = "power-state"
+  memory         = 4
+  password       = "Secret-Passw0rd"
+  power_state    = %[1]q
+  swap_disk_size = 2
+  template_id    = "template-id"
+  tenant_id      = "tenant-123"
+
+  networks = [{
+    connected = true
+    id        = "network-id"
+  }]
+}
+`, powerState)
+}
+
+func TestResourceXelonDevice_RebootTrigger(t *testing.T) {
+	server := newFakeXelonServer(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// create device with reboot trigger
+			{
+				Config: server.ProviderConfig() + testDeviceRebootTriggerConfig("on", "1"),
+				Check: func(_ *terraform.State) error {
+					return testDeviceExpectStarts(server, 0)
+				},
+			},
+			// changed reboot trigger reboots device
+			{
+				Config: server.ProviderConfig() + testDeviceRebootTriggerConfig("on", "2"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("xelon_device.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("xelon_device.test", "reboot_trigger", "2"),
+					func(_ *terraform.State) error {
+						return testDeviceExpectStarts(server, 1)
+					},
+					func(_ *terraform.State) error {
+						return testDeviceExpectPoweredOn(server, true)
+					},
+				),
+			},
+			// changed reboot trigger does not start powered off device
+			{
+				Config: server.ProviderConfig() + testDeviceRebootTriggerConfig("off", "3"),
+				Check: resource.ComposeTestCheckFunc(
+					func(_ *terraform.State) error {
+						return testDeviceExpectStarts(server, 1)
+					},
+					func(_ *terraform.State) error {
+						return testDeviceExpectPoweredOn(server, false)
+					},
+				),
+			},
+		},
+	})
+}
+
+func testDeviceExpectStarts(server *fakeXelonServer, starts int) error {
+	var err error
+	server.Mutate(func(s *fakeXelonServer) {
+		for _, device := range s.devices {
+			if s.deviceStarts[device.ID] != starts {
+				err = fmt.Errorf("expected device %s to be started %d times, got %d", device.ID, starts, s.deviceStarts[device.ID])
+			}
+		}
+	})
+	return err
+}
+
+func testDeviceRebootTriggerConfig(powerState, rebootTrigger string) string {
+	return fmt.Sprintf(`
+resource "xelon_device" "test" {
+  cpu_core_count = 2
+  disk_size      = 20
+  display_name   = "reboot-trigger"
+  hostname       = "reboot-trigger"
+  memory         = 4
+  password       = "Secret-Passw0rd"
+  power_state    = %[1]q
+  reboot_trigger = %[2]q
+  swap_disk_size = 2
+  template_id    = "template-id"
+  tenant_id      = "tenant-123"
+
+  networks = [{
+    connected = true
+    id        = "network-id"
+  }]
+}
+`, powerState, rebootTrigger)
+}
+
 func testDeviceResourceSchema(t *testing.T) schema.Schema {
 	t.Helper()
 
 	r := NewDeviceResource()
-	response := &resource.SchemaResponse{}
-	r.Schema(context.Background(), resource.SchemaRequest{}, response)
+	response := &fwresource.SchemaResponse{}
+	r.Schema(context.Background(), fwresource.SchemaRequest{}, response)
 	require.False(t, response.Diagnostics.HasError())
 
 	return response.Schema
@@ -367,15 +716,23 @@ func testDeviceResourcePlanWithTemplateID(t *testing.T, ctx context.Context, dev
 				IPAddressID: types.StringNull(),
 			},
 		},
-		Password:     password,
-		SendEmail:    types.BoolNull(),
-		SSHKeyID:     types.StringNull(),
-		ScriptID:     types.StringNull(),
-		SwapDiskID:   types.StringUnknown(),
-		SwapDiskSize: types.Int64Value(1),
-		TemplateID:   types.StringValue(templateID),
-		TenantID:     types.StringValue("tenant-id"),
-		UserData:     userData,
+		Password:          password,
+		PasswordWO:        types.StringNull(),
+		PasswordWOVersion: types.Int64Null(),
+		PowerState:        types.StringUnknown(),
+		SendEmail:         types.BoolNull(),
+		SSHKeyID:          types.StringNull(),
+		ScriptID:          types.StringNull(),
+		SwapDiskID:        types.StringUnknown(),
+		SwapDiskSize:      types.Int64Value(1),
+		TemplateID:        types.StringValue(templateID),
+		TenantID:          types.StringValue("tenant-id"),
+		Timeouts: timeouts.Value{Object: types.ObjectNull(map[string]attr.Type{
+			"create": types.StringType,
+			"delete": types.StringType,
+			"update": types.StringType,
+		})},
+		UserData: userData,
 	})
 	require.False(t, diags.HasError())
 
@@ -439,7 +796,7 @@ func expectedDeviceMissingPasswordOrUserDataDiagnostics() diag.Diagnostics {
 		diag.NewAttributeErrorDiagnostic(
 			path.Root("password"),
 			"Missing password or user_data",
-			`Either "password" or "user_data" must be specified when creating or replacing a device.`,
+			`Either "password", "password_wo", or "user_data" must be specified when creating or replacing a device.`,
 		),
 	}
 }
@@ -4,12 +4,17 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"slices"
 	"strconv"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -18,31 +23,58 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
+	"github.com/Xelon-AG/terraform-provider-xelon/internal/provider/helper"
 	"github.com/Xelon-AG/xelon-sdk-go/xelon"
 )
 
 var (
-	_ resource.Resource                = (*dnsRecordResource)(nil)
-	_ resource.ResourceWithConfigure   = (*dnsRecordResource)(nil)
-	_ resource.ResourceWithImportState = (*dnsRecordResource)(nil)
+	_ resource.Resource                   = (*dnsRecordResource)(nil)
+	_ resource.ResourceWithConfigure      = (*dnsRecordResource)(nil)
+	_ resource.ResourceWithImportState    = (*dnsRecordResource)(nil)
+	_ resource.ResourceWithIdentity       = (*dnsRecordResource)(nil)
+	_ resource.ResourceWithValidateConfig = (*dnsRecordResource)(nil)
 )
 
 // dnsRecordResource is the dns record resource implementation.
 type dnsRecordResource struct {
+	withReadOnlyMode
+
 	client *xelon.Client
 }
 
 // dnsRecordResourceModel maps the dns record resource schema data.
 type dnsRecordResourceModel struct {
 	Content  types.String `tfsdk:"content"`
+	Flags    types.Int64  `tfsdk:"flags"`
 	ID       types.String `tfsdk:"id"`
 	Name     types.String `tfsdk:"name"`
+	Port     types.Int64  `tfsdk:"port"`
+	Priority types.Int64  `tfsdk:"priority"`
 	RecordID types.Int64  `tfsdk:"record_id"`
+	Tag      types.String `tfsdk:"tag"`
 	TTL      types.Int64  `tfsdk:"ttl"`
 	Type     types.String `tfsdk:"type"`
+	Weight   types.Int64  `tfsdk:"weight"`
 	ZoneID   types.String `tfsdk:"zone_id"`
 }
 
+// dnsRecordResourceIdentityModel maps the DNS record resource identity schema data.
+type dnsRecordResourceIdentityModel struct {
+	RecordID types.Int64  `tfsdk:"record_id"`
+	ZoneID   types.String `tfsdk:"zone_id"`
+}
+
+// dnsRecordContent holds the structured parts of a DNS record, which are
+// serialized into a single record value by the backend API.
+type dnsRecordContent struct {
+	Content  string
+	Flags    int64
+	Port     int64
+	Priority int64
+	Tag      string
+	Weight   int64
+}
+
 func NewDNSRecordResource() resource.Resource {
 	return &dnsRecordResource{}
 }
@@ -56,18 +88,27 @@ func (r *dnsRecordResource) Schema(_ context.Context, _ resource.SchemaRequest,
 		MarkdownDescription: `
 The DNS record resource allows you to manage DNS records in a Xelon DNS zone.
 
-Supported record types in v0: A, AAAA, CNAME, TXT, NS, ALIAS, PTR.
-Record types that require additional structured fields, such as MX, SRV, CAA, RP, SSHFP, and TLSA, are not supported yet.
+Supported record types: A, AAAA, ALIAS, CAA, CNAME, MX, NS, PTR, SRV, TXT. SPF policies are managed as TXT records.
+MX, SRV, and CAA records are configured with the additional structured fields priority, weight, port, flags, and tag.
+Record types such as RP, SSHFP, and TLSA are not supported yet.
 `,
 		Version: 0,
 		Attributes: map[string]schema.Attribute{
 			"content": schema.StringAttribute{
-				MarkdownDescription: "DNS record content/value, such as an IP address, hostname, or TXT value.",
-				Required:            true,
+				MarkdownDescription: "DNS record content/value, such as an IP address, hostname, or TXT value. " +
+					"For `MX` records this is the mail server, for `SRV` records the target host, and for `CAA` records the property value.",
+				Required: true,
 				Validators: []validator.String{
 					stringvalidator.LengthAtLeast(1),
 				},
 			},
+			"flags": schema.Int64Attribute{
+				MarkdownDescription: "CAA record flags, such as `0` or `128`. Required for `CAA` records.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, 255),
+				},
+			},
 			"id": schema.StringAttribute{
 				MarkdownDescription: "ID of the DNS record in the format `<zone_id>/<record_id>`.",
 				Computed:            true,
@@ -82,6 +123,20 @@ Record types that require additional structured fields, such as MX, SRV, CAA, RP
 					stringvalidator.LengthAtLeast(1),
 				},
 			},
+			"port": schema.Int64Attribute{
+				MarkdownDescription: "SRV record target port. Required for `SRV` records.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, 65535),
+				},
+			},
+			"priority": schema.Int64Attribute{
+				MarkdownDescription: "Record priority. Required for `MX` and `SRV` records.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, 65535),
+				},
+			},
 			"record_id": schema.Int64Attribute{
 				MarkdownDescription: "Backend DNS record ID.",
 				Computed:            true,
@@ -89,6 +144,13 @@ Record types that require additional structured fields, such as MX, SRV, CAA, RP
 					int64planmodifier.UseStateForUnknown(),
 				},
 			},
+			"tag": schema.StringAttribute{
+				MarkdownDescription: "CAA record property tag. Must be one of `issue`, `issuewild`, or `iodef`. Required for `CAA` records.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("issue", "issuewild", "iodef"),
+				},
+			},
 			"ttl": schema.Int64Attribute{
 				MarkdownDescription: "DNS record TTL in seconds.",
 				Required:            true,
@@ -97,12 +159,19 @@ Record types that require additional structured fields, such as MX, SRV, CAA, RP
 				},
 			},
 			"type": schema.StringAttribute{
-				MarkdownDescription: "DNS record type. Supported v0 types are `A`, `AAAA`, `CNAME`, `TXT`, `NS`, `ALIAS`, and `PTR`.",
+				MarkdownDescription: "DNS record type. Supported types are `A`, `AAAA`, `ALIAS`, `CAA`, `CNAME`, `MX`, `NS`, `PTR`, `SRV`, and `TXT`.",
 				Required:            true,
 				Validators: []validator.String{
 					stringvalidator.OneOf(supportedV0DNSRecordTypes()...),
 				},
 			},
+			"weight": schema.Int64Attribute{
+				MarkdownDescription: "SRV record weight. Required for `SRV` records.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, 65535),
+				},
+			},
 			"zone_id": schema.StringAttribute{
 				MarkdownDescription: "ID of the DNS zone owning this record.",
 				Required:            true,
@@ -117,6 +186,21 @@ Record types that require additional structured fields, such as MX, SRV, CAA, RP
 	}
 }
 
+func (r *dnsRecordResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, response *resource.IdentitySchemaResponse) {
+	response.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"record_id": identityschema.Int64Attribute{
+				Description:       "Backend DNS record ID.",
+				RequiredForImport: true,
+			},
+			"zone_id": identityschema.StringAttribute{
+				Description:       "ID of the DNS zone owning this record.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *dnsRecordResource) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
 	if request.ProviderData == nil {
 		return
@@ -135,6 +219,10 @@ func (r *dnsRecordResource) Configure(_ context.Context, request resource.Config
 }
 
 func (r *dnsRecordResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	if r.checkReadOnly("create", &response.Diagnostics) {
+		return
+	}
+
 	var data dnsRecordResourceModel
 
 	// read plan data into the model
@@ -147,7 +235,7 @@ func (r *dnsRecordResource) Create(ctx context.Context, request resource.CreateR
 	zoneID := data.ZoneID.ValueString()
 	createRequest := &xelon.DNSRecordCreateRequest{
 		Host:   data.Name.ValueString(),
-		Record: data.Content.ValueString(),
+		Record: buildDNSRecordContent(data.Type.ValueString(), data.content()),
 		TTL:    int(data.TTL.ValueInt64()),
 		Type:   xelon.DNSRecordType(data.Type.ValueString()),
 	}
@@ -170,7 +258,7 @@ func (r *dnsRecordResource) Create(ctx context.Context, request resource.CreateR
 	}
 
 	tflog.Trace(ctx, "listing DNS records via API (created record lookup)", map[string]any{"zone_id": zoneID})
-	records, resp, err := r.client.Domains.ListRecords(ctx, zoneID)
+	records, resp, err := r.client.Domains.ListRecords(helper.WithReadCache(ctx), zoneID)
 	if err != nil {
 		if resp != nil && resp.StatusCode == http.StatusNotFound {
 			response.Diagnostics.AddError(
@@ -207,6 +295,7 @@ func (r *dnsRecordResource) Create(ctx context.Context, request resource.CreateR
 
 	diags = response.State.Set(ctx, &data)
 	response.Diagnostics.Append(diags...)
+	setResourceIdentity(ctx, response.Identity, dnsRecordResourceIdentityModel{RecordID: data.RecordID, ZoneID: data.ZoneID}, &response.Diagnostics)
 }
 
 func (r *dnsRecordResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
@@ -231,7 +320,7 @@ func (r *dnsRecordResource) Read(ctx context.Context, request resource.ReadReque
 	})
 
 	tflog.Trace(ctx, "listing DNS records via API (record lookup)", map[string]any{"zone_id": zoneID})
-	records, resp, err := r.client.Domains.ListRecords(ctx, zoneID)
+	records, resp, err := r.client.Domains.ListRecords(helper.WithReadCache(ctx), zoneID)
 	if err != nil {
 		if resp != nil && resp.StatusCode == http.StatusNotFound {
 			response.State.RemoveResource(ctx)
@@ -251,9 +340,14 @@ func (r *dnsRecordResource) Read(ctx context.Context, request resource.ReadReque
 
 	diags = response.State.Set(ctx, &data)
 	response.Diagnostics.Append(diags...)
+	setResourceIdentity(ctx, response.Identity, dnsRecordResourceIdentityModel{RecordID: data.RecordID, ZoneID: data.ZoneID}, &response.Diagnostics)
 }
 
 func (r *dnsRecordResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	if r.checkReadOnly("update", &response.Diagnostics) {
+		return
+	}
+
 	var data dnsRecordResourceModel
 
 	// read plan data into the model
@@ -271,7 +365,7 @@ func (r *dnsRecordResource) Update(ctx context.Context, request resource.UpdateR
 
 	updateRequest := &xelon.DNSRecordUpdateRequest{
 		Host:   data.Name.ValueString(),
-		Record: data.Content.ValueString(),
+		Record: buildDNSRecordContent(data.Type.ValueString(), data.content()),
 		TTL:    int(data.TTL.ValueInt64()),
 		Type:   xelon.DNSRecordType(data.Type.ValueString()),
 	}
@@ -296,7 +390,7 @@ func (r *dnsRecordResource) Update(ctx context.Context, request resource.UpdateR
 	}
 
 	tflog.Trace(ctx, "listing DNS records via API (updated record lookup)", map[string]any{"zone_id": zoneID})
-	records, resp, err := r.client.Domains.ListRecords(ctx, zoneID)
+	records, resp, err := r.client.Domains.ListRecords(helper.WithReadCache(ctx), zoneID)
 	if err != nil {
 		if resp != nil && resp.StatusCode == http.StatusNotFound {
 			response.Diagnostics.AddError(
@@ -327,9 +421,14 @@ func (r *dnsRecordResource) Update(ctx context.Context, request resource.UpdateR
 
 	diags = response.State.Set(ctx, &data)
 	response.Diagnostics.Append(diags...)
+	setResourceIdentity(ctx, response.Identity, dnsRecordResourceIdentityModel{RecordID: data.RecordID, ZoneID: data.ZoneID}, &response.Diagnostics)
 }
 
 func (r *dnsRecordResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	if r.checkReadOnly("delete", &response.Diagnostics) {
+		return
+	}
+
 	var data dnsRecordResourceModel
 
 	// read state data into the model
@@ -370,11 +469,22 @@ func (r *dnsRecordResource) Delete(ctx context.Context, request resource.DeleteR
 }
 
 func (r *dnsRecordResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
-	zoneID, recordID, err := parseDNSRecordCompositeID(request.ID)
-	if err != nil {
-		response.Diagnostics.AddError("Invalid import identifier", err.Error())
-		return
+	var identity dnsRecordResourceIdentityModel
+	if request.ID != "" {
+		zoneID, recordID, err := parseDNSRecordCompositeID(request.ID)
+		if err != nil {
+			response.Diagnostics.AddError("Invalid import identifier", err.Error())
+			return
+		}
+		identity = dnsRecordResourceIdentityModel{RecordID: types.Int64Value(recordID), ZoneID: types.StringValue(zoneID)}
+	} else {
+		response.Diagnostics.Append(request.Identity.Get(ctx, &identity)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
 	}
+	zoneID := identity.ZoneID.ValueString()
+	recordID := identity.RecordID.ValueInt64()
 
 	tflog.Debug(ctx, "importing DNS record", map[string]any{
 		"zone_id":   zoneID,
@@ -382,7 +492,7 @@ func (r *dnsRecordResource) ImportState(ctx context.Context, request resource.Im
 	})
 
 	tflog.Trace(ctx, "listing DNS records via API (import record lookup)", map[string]any{"zone_id": zoneID})
-	records, resp, err := r.client.Domains.ListRecords(ctx, zoneID)
+	records, resp, err := r.client.Domains.ListRecords(helper.WithReadCache(ctx), zoneID)
 	if err != nil {
 		if resp != nil && resp.StatusCode == http.StatusNotFound {
 			response.Diagnostics.AddError("Unable to import DNS record", "The parent DNS zone was not found.")
@@ -403,18 +513,77 @@ func (r *dnsRecordResource) ImportState(ctx context.Context, request resource.Im
 
 	diags := response.State.Set(ctx, &data)
 	response.Diagnostics.Append(diags...)
+	setResourceIdentity(ctx, response.Identity, identity, &response.Diagnostics)
+}
+
+func (r *dnsRecordResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var data dnsRecordResourceModel
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	if data.Type.IsNull() || data.Type.IsUnknown() {
+		return
+	}
+
+	response.Diagnostics.Append(validateDNSRecordStructuredAttributes(
+		data.Type.ValueString(),
+		map[string]attr.Value{
+			"flags":    data.Flags,
+			"port":     data.Port,
+			"priority": data.Priority,
+			"tag":      data.Tag,
+			"weight":   data.Weight,
+		},
+		path.Empty(),
+	)...)
 }
 
 func (m *dnsRecordResourceModel) fromAPI(record *xelon.DNSRecord, zoneID string) {
 	recordID := int64(record.ID)
 
 	m.Content = types.StringValue(record.Record)
+	m.Flags = types.Int64Null()
 	m.ID = types.StringValue(buildDNSRecordCompositeID(zoneID, recordID))
 	m.Name = types.StringValue(record.Host)
+	m.Port = types.Int64Null()
+	m.Priority = types.Int64Null()
 	m.RecordID = types.Int64Value(recordID)
+	m.Tag = types.StringNull()
 	m.TTL = types.Int64Value(int64(record.TTL))
 	m.Type = types.StringValue(string(record.Type))
+	m.Weight = types.Int64Null()
 	m.ZoneID = types.StringValue(zoneID)
+
+	// keep the raw record value as content if it cannot be split into its structured parts
+	content, ok := parseDNSRecordContent(string(record.Type), record.Record)
+	if !ok {
+		return
+	}
+	m.Content = types.StringValue(content.Content)
+	switch record.Type {
+	case xelon.DNSRecordTypeCAA:
+		m.Flags = types.Int64Value(content.Flags)
+		m.Tag = types.StringValue(content.Tag)
+	case xelon.DNSRecordTypeMX:
+		m.Priority = types.Int64Value(content.Priority)
+	case xelon.DNSRecordTypeSRV:
+		m.Port = types.Int64Value(content.Port)
+		m.Priority = types.Int64Value(content.Priority)
+		m.Weight = types.Int64Value(content.Weight)
+	}
+}
+
+func (m *dnsRecordResourceModel) content() dnsRecordContent {
+	return dnsRecordContent{
+		Content:  m.Content.ValueString(),
+		Flags:    m.Flags.ValueInt64(),
+		Port:     m.Port.ValueInt64(),
+		Priority: m.Priority.ValueInt64(),
+		Tag:      m.Tag.ValueString(),
+		Weight:   m.Weight.ValueInt64(),
+	}
 }
 
 func buildDNSRecordCompositeID(zoneID string, recordID int64) string {
@@ -457,7 +626,7 @@ func findCreatedDNSRecord(records []xelon.DNSRecord, data *dnsRecordResourceMode
 		if record.Type != xelon.DNSRecordType(data.Type.ValueString()) {
 			continue
 		}
-		if record.Record != data.Content.ValueString() {
+		if !dnsRecordContentMatches(record, data.content()) {
 			continue
 		}
 		if int64(record.TTL) != data.TTL.ValueInt64() {
@@ -476,9 +645,146 @@ func supportedV0DNSRecordTypes() []string {
 		string(xelon.DNSRecordTypeA),
 		string(xelon.DNSRecordTypeAAAA),
 		string(xelon.DNSRecordTypeALIAS),
+		string(xelon.DNSRecordTypeCAA),
 		string(xelon.DNSRecordTypeCNAME),
+		string(xelon.DNSRecordTypeMX),
 		string(xelon.DNSRecordTypeNS),
 		string(xelon.DNSRecordTypePTR),
+		string(xelon.DNSRecordTypeSRV),
 		string(xelon.DNSRecordTypeTXT),
 	}
 }
+
+// dnsRecordStructuredAttributeTypes maps the structured record attributes to
+// the record types requiring them.
+func dnsRecordStructuredAttributeTypes() map[string][]string {
+	return map[string][]string{
+		"flags":    {string(xelon.DNSRecordTypeCAA)},
+		"port":     {string(xelon.DNSRecordTypeSRV)},
+		"priority": {string(xelon.DNSRecordTypeMX), string(xelon.DNSRecordTypeSRV)},
+		"tag":      {string(xelon.DNSRecordTypeCAA)},
+		"weight":   {string(xelon.DNSRecordTypeSRV)},
+	}
+}
+
+// validateDNSRecordStructuredAttributes checks that exactly the structured
+// attributes required by recordType are configured. Attribute errors are
+// reported relative to basePath.
+func validateDNSRecordStructuredAttributes(recordType string, values map[string]attr.Value, basePath path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for attributeName, recordTypes := range dnsRecordStructuredAttributeTypes() {
+		required := slices.Contains(recordTypes, recordType)
+		configured := !values[attributeName].IsNull()
+
+		if required && !configured {
+			diags.AddAttributeError(
+				basePath.AtName(attributeName),
+				"Missing required attribute",
+				fmt.Sprintf("Attribute %q is required for %s records.", attributeName, recordType),
+			)
+		}
+		if !required && configured {
+			diags.AddAttributeError(
+				basePath.AtName(attributeName),
+				"Invalid attribute combination",
+				fmt.Sprintf("Attribute %q can only be configured for %s records.", attributeName, strings.Join(recordTypes, " and ")),
+			)
+		}
+	}
+
+	return diags
+}
+
+// buildDNSRecordContent serializes the structured record parts into the
+// zone file presentation format used by the backend API, e.g.
+// "10 mail.example.com" for MX or `0 issue "letsencrypt.org"` for CAA records.
+func buildDNSRecordContent(recordType string, content dnsRecordContent) string {
+	switch xelon.DNSRecordType(recordType) {
+	case xelon.DNSRecordTypeCAA:
+		return fmt.Sprintf(`%d %s "%s"`, content.Flags, content.Tag, dnsCharacterStringEscaper.Replace(content.Content))
+	case xelon.DNSRecordTypeMX:
+		return fmt.Sprintf("%d %s", content.Priority, content.Content)
+	case xelon.DNSRecordTypeSRV:
+		return fmt.Sprintf("%d %d %d %s", content.Priority, content.Weight, content.Port, content.Content)
+	default:
+		return content.Content
+	}
+}
+
+// parseDNSRecordContent is the inverse of buildDNSRecordContent. It reports
+// false if the record value does not match the expected format of its type.
+func parseDNSRecordContent(recordType string, record string) (dnsRecordContent, bool) {
+	switch xelon.DNSRecordType(recordType) {
+	case xelon.DNSRecordTypeCAA:
+		parts := strings.SplitN(strings.TrimSpace(record), " ", 3)
+		if len(parts) != 3 {
+			return dnsRecordContent{}, false
+		}
+		flags, err := strconv.ParseInt(parts[0], 10, 64)
+		if err != nil {
+			return dnsRecordContent{}, false
+		}
+		return dnsRecordContent{Content: unquoteDNSCharacterString(strings.TrimSpace(parts[2])), Flags: flags, Tag: parts[1]}, true
+	case xelon.DNSRecordTypeMX:
+		parts := strings.Fields(record)
+		if len(parts) != 2 {
+			return dnsRecordContent{}, false
+		}
+		priority, err := strconv.ParseInt(parts[0], 10, 64)
+		if err != nil {
+			return dnsRecordContent{}, false
+		}
+		return dnsRecordContent{Content: parts[1], Priority: priority}, true
+	case xelon.DNSRecordTypeSRV:
+		parts := strings.Fields(record)
+		if len(parts) != 4 {
+			return dnsRecordContent{}, false
+		}
+		var values [3]int64
+		for i := range values {
+			value, err := strconv.ParseInt(parts[i], 10, 64)
+			if err != nil {
+				return dnsRecordContent{}, false
+			}
+			values[i] = value
+		}
+		return dnsRecordContent{Content: parts[3], Port: values[2], Priority: values[0], Weight: values[1]}, true
+	default:
+		return dnsRecordContent{Content: record}, true
+	}
+}
+
+// dnsCharacterStringEscaper escapes a value for a quoted character string
+// of the zone file presentation format, see unquoteDNSCharacterString.
+var dnsCharacterStringEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+
+// unquoteDNSCharacterString removes the quotes of a character string and
+// the backslash of every escaped character. Unquoted values are returned
+// as is.
+func unquoteDNSCharacterString(value string) string {
+	if len(value) < 2 || value[0] != '"' || value[len(value)-1] != '"' {
+		return value
+	}
+
+	var unquoted strings.Builder
+	escaped := false
+	for _, r := range value[1 : len(value)-1] {
+		if r == '\\' && !escaped {
+			escaped = true
+			continue
+		}
+		escaped = false
+		unquoted.WriteRune(r)
+	}
+	return unquoted.String()
+}
+
+func dnsRecordContentMatches(record *xelon.DNSRecord, content dnsRecordContent) bool {
+	recordContent, ok := parseDNSRecordContent(string(record.Type), record.Record)
+	if !ok {
+		return record.Record == buildDNSRecordContent(string(record.Type), content)
+	}
+
+	return recordContent == content
+}
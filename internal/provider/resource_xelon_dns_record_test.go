@@ -1,10 +1,18 @@
 package provider
 
 import (
+	"fmt"
+	"net/http"
+	"regexp"
 	"slices"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -131,15 +139,15 @@ func TestResourceXelonDNSRecord_SupportedV0RecordTypes(t *testing.T) {
 		string(xelon.DNSRecordTypeNS),
 		string(xelon.DNSRecordTypeALIAS),
 		string(xelon.DNSRecordTypePTR),
+		string(xelon.DNSRecordTypeMX),
+		string(xelon.DNSRecordTypeSRV),
+		string(xelon.DNSRecordTypeCAA),
 	}
 	for _, recordType := range expectedSupportedTypes {
 		assert.Truef(t, slices.Contains(supportedTypes, recordType), "expected %s to be supported", recordType)
 	}
 
 	expectedUnsupportedTypes := []string{
-		string(xelon.DNSRecordTypeMX),
-		string(xelon.DNSRecordTypeSRV),
-		string(xelon.DNSRecordTypeCAA),
 		string(xelon.DNSRecordTypeRP),
 		string(xelon.DNSRecordTypeSSHFP),
 		string(xelon.DNSRecordTypeTLSA),
@@ -148,3 +156,384 @@ func TestResourceXelonDNSRecord_SupportedV0RecordTypes(t *testing.T) {
 		assert.Falsef(t, slices.Contains(supportedTypes, recordType), "expected %s to be unsupported", recordType)
 	}
 }
+
+func TestResourceXelonDNSRecord_Content_BuildAndParse(t *testing.T) {
+	testCases := map[string]struct {
+		recordType string
+		content    dnsRecordContent
+		expected   string
+	}{
+		"A": {
+			recordType: "A",
+			content:    dnsRecordContent{Content: "203.0.113.10"},
+			expected:   "203.0.113.10",
+		},
+		"TXT": {
+			recordType: "TXT",
+			content:    dnsRecordContent{Content: "v=spf1 include:_spf.example.com ~all"},
+			expected:   "v=spf1 include:_spf.example.com ~all",
+		},
+		"MX": {
+			recordType: "MX",
+			content:    dnsRecordContent{Content: "mail.example.com", Priority: 10},
+			expected:   "10 mail.example.com",
+		},
+		"SRV": {
+			recordType: "SRV",
+			content:    dnsRecordContent{Content: "sip.example.com", Port: 5060, Priority: 10, Weight: 5},
+			expected:   "10 5 5060 sip.example.com",
+		},
+		"CAA": {
+			recordType: "CAA",
+			content:    dnsRecordContent{Content: "letsencrypt.org", Flags: 0, Tag: "issue"},
+			expected:   `0 issue "letsencrypt.org"`,
+		},
+		"CAA with quotes": {
+			recordType: "CAA",
+			content:    dnsRecordContent{Content: `mailto:"security"@example.com`, Flags: 128, Tag: "iodef"},
+			expected:   `128 iodef "mailto:\"security\"@example.com"`,
+		},
+		"CAA with backslashes": {
+			recordType: "CAA",
+			content:    dnsRecordContent{Content: `letsencrypt.org; path=C:\certs\"a"`, Flags: 0, Tag: "issue"},
+			expected:   `0 issue "letsencrypt.org; path=C:\\certs\\\"a\""`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			record := buildDNSRecordContent(testCase.recordType, testCase.content)
+			assert.Equal(t, testCase.expected, record)
+
+			content, ok := parseDNSRecordContent(testCase.recordType, record)
+			require.True(t, ok)
+			assert.Equal(t, testCase.content, content)
+		})
+	}
+}
+
+func TestResourceXelonDNSRecord_Content_ParseInvalid(t *testing.T) {
+	testCases := map[string]string{
+		"MX":  "mail.example.com",
+		"SRV": "10 5 sip.example.com",
+		"CAA": "issue letsencrypt.org",
+	}
+
+	for recordType, record := range testCases {
+		t.Run(recordType, func(t *testing.T) {
+			_, ok := parseDNSRecordContent(recordType, record)
+			assert.False(t, ok)
+		})
+	}
+}
+
+func TestResourceXelonDNSRecord_Model_FromAPI_StructuredRecord(t *testing.T) {
+	var model dnsRecordResourceModel
+	model.fromAPI(&xelon.DNSRecord{
+		Host:   "_sip._tcp",
+		ID:     300,
+		Record: "10 5 5060 sip.example.com",
+		TTL:    3600,
+		Type:   xelon.DNSRecordTypeSRV,
+	}, "zone-123")
+
+	assert.Equal(t, dnsRecordResourceModel{
+		Content:  types.StringValue("sip.example.com"),
+		Flags:    types.Int64Null(),
+		ID:       types.StringValue("zone-123/300"),
+		Name:     types.StringValue("_sip._tcp"),
+		Port:     types.Int64Value(5060),
+		Priority: types.Int64Value(10),
+		RecordID: types.Int64Value(300),
+		Tag:      types.StringNull(),
+		TTL:      types.Int64Value(3600),
+		Type:     types.StringValue("SRV"),
+		Weight:   types.Int64Value(5),
+		ZoneID:   types.StringValue("zone-123"),
+	}, model)
+}
+
+func TestResourceXelonDNSRecord_Model_FromAPI_UnparsableStructuredRecord(t *testing.T) {
+	var model dnsRecordResourceModel
+	model.fromAPI(&xelon.DNSRecord{
+		Host:   "@",
+		ID:     300,
+		Record: "mail.example.com",
+		TTL:    3600,
+		Type:   xelon.DNSRecordTypeMX,
+	}, "zone-123")
+
+	assert.Equal(t, types.StringValue("mail.example.com"), model.Content)
+	assert.Equal(t, types.Int64Null(), model.Priority)
+}
+
+func TestResourceXelonDNSRecord_CreatedRecordLookup_StructuredRecord(t *testing.T) {
+	planned := &dnsRecordResourceModel{
+		Content:  types.StringValue("mail.example.com"),
+		Name:     types.StringValue("@"),
+		Priority: types.Int64Value(20),
+		TTL:      types.Int64Value(3600),
+		Type:     types.StringValue("MX"),
+	}
+	records := []xelon.DNSRecord{
+		{ID: 100, Host: "@", Record: "10 mail.example.com", TTL: 3600, Type: xelon.DNSRecordTypeMX},
+		{ID: 200, Host: "@", Record: "20  mail.example.com", TTL: 3600, Type: xelon.DNSRecordTypeMX},
+	}
+
+	record, matchCount := findCreatedDNSRecord(records, planned)
+
+	require.Equal(t, 1, matchCount)
+	require.NotNil(t, record)
+	assert.Equal(t, 200, record.ID)
+}
+
+func TestResourceXelonDNSRecord_Lifecycle(t *testing.T) {
+	server := newFakeXelonServer(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// create and read
+			{
+				Config: server.ProviderConfig() + testAccResourceXelonDNSRecordConfig("example.com", "www", "203.0.113.10", 1800),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"xelon_dns_record.test",
+						tfjsonpath.New("content"),
+						knownvalue.StringExact("203.0.113.10"),
+					),
+					statecheck.ExpectKnownValue(
+						"xelon_dns_record.test",
+						tfjsonpath.New("id"),
+						knownvalue.StringExact("1/2"),
+					),
+					statecheck.ExpectKnownValue(
+						"xelon_dns_record.test",
+						tfjsonpath.New("record_id"),
+						knownvalue.Int64Exact(2),
+					),
+					statecheck.ExpectKnownValue(
+						"xelon_dns_record.test",
+						tfjsonpath.New("ttl"),
+						knownvalue.Int64Exact(1800),
+					),
+				},
+			},
+			// ImportState testing
+			{
+				ResourceName:      "xelon_dns_record.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// update and read
+			{
+				Config: server.ProviderConfig() + testAccResourceXelonDNSRecordConfig("example.com", "www", "203.0.113.20", 3600),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("xelon_dns_record.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"xelon_dns_record.test",
+						tfjsonpath.New("content"),
+						knownvalue.StringExact("203.0.113.20"),
+					),
+					statecheck.ExpectKnownValue(
+						"xelon_dns_record.test",
+						tfjsonpath.New("id"),
+						knownvalue.StringExact("1/2"),
+					),
+					statecheck.ExpectKnownValue(
+						"xelon_dns_record.test",
+						tfjsonpath.New("ttl"),
+						knownvalue.Int64Exact(3600),
+					),
+				},
+			},
+			// record deleted outside of terraform is re-created
+			{
+				PreConfig: func() {
+					server.Mutate(func(s *fakeXelonServer) {
+						clear(s.dnsRecords)
+					})
+				},
+				Config: server.ProviderConfig() + testAccResourceXelonDNSRecordConfig("example.com", "www", "203.0.113.20", 3600),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("xelon_dns_record.test", plancheck.ResourceActionCreate),
+					},
+				},
+			},
+			// unexpected API error during refresh
+			{
+				PreConfig: func() {
+					server.InjectError(http.MethodGet, "dns/zones/1/records", http.StatusInternalServerError, 1)
+				},
+				Config:      server.ProviderConfig() + testAccResourceXelonDNSRecordConfig("example.com", "www", "203.0.113.20", 3600),
+				ExpectError: regexp.MustCompile(`Unable to read DNS record`),
+			},
+		},
+	})
+}
+
+func TestResourceXelonDNSRecord_ImportMissingRecord(t *testing.T) {
+	server := newFakeXelonServer(t)
+	server.Mutate(func(s *fakeXelonServer) {
+		s.dnsZones["1"] = &xelon.DNSZone{ID: "1", Name: "example.com"}
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:        server.ProviderConfig() + testAccResourceXelonDNSRecordConfig("example.com", "www", "203.0.113.10", 1800),
+				ResourceName:  "xelon_dns_record.test",
+				ImportState:   true,
+				ImportStateId: "1/42",
+				ExpectError:   regexp.MustCompile(`No DNS record with the given backend record ID`),
+			},
+		},
+	})
+}
+
+func TestResourceXelonDNSRecord_StructuredRecordTypes(t *testing.T) {
+	server := newFakeXelonServer(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// create and read
+			{
+				Config: server.ProviderConfig() + testResourceXelonDNSRecordStructuredConfig("example.com", 10),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"xelon_dns_record.caa",
+						tfjsonpath.New("content"),
+						knownvalue.StringExact("letsencrypt.org"),
+					),
+					statecheck.ExpectKnownValue(
+						"xelon_dns_record.caa",
+						tfjsonpath.New("tag"),
+						knownvalue.StringExact("issue"),
+					),
+					statecheck.ExpectKnownValue(
+						"xelon_dns_record.mx",
+						tfjsonpath.New("content"),
+						knownvalue.StringExact("mail.example.com"),
+					),
+					statecheck.ExpectKnownValue(
+						"xelon_dns_record.mx",
+						tfjsonpath.New("priority"),
+						knownvalue.Int64Exact(10),
+					),
+					statecheck.ExpectKnownValue(
+						"xelon_dns_record.srv",
+						tfjsonpath.New("port"),
+						knownvalue.Int64Exact(5060),
+					),
+				},
+			},
+			// ImportState testing
+			{
+				ResourceName:      "xelon_dns_record.caa",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "xelon_dns_record.srv",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// update and read
+			{
+				Config: server.ProviderConfig() + testResourceXelonDNSRecordStructuredConfig("example.com", 20),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("xelon_dns_record.mx", plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"xelon_dns_record.mx",
+						tfjsonpath.New("priority"),
+						knownvalue.Int64Exact(20),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestResourceXelonDNSRecord_StructuredAttributesValidation(t *testing.T) {
+	server := newFakeXelonServer(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + `
+resource "xelon_dns_record" "test" {
+  content = "mail.example.com"
+  name    = "@"
+  ttl     = 3600
+  type    = "MX"
+  zone_id = "1"
+}
+`,
+				ExpectError: regexp.MustCompile(`Missing required attribute`),
+			},
+			{
+				Config: server.ProviderConfig() + `
+resource "xelon_dns_record" "test" {
+  content  = "203.0.113.10"
+  name     = "www"
+  priority = 10
+  ttl      = 3600
+  type     = "A"
+  zone_id  = "1"
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid attribute combination`),
+			},
+		},
+	})
+}
+
+func testResourceXelonDNSRecordStructuredConfig(dnsZoneName string, mxPriority int64) string {
+	return fmt.Sprintf(`
+resource "xelon_dns_record" "caa" {
+  content = "letsencrypt.org"
+  flags   = 0
+  name    = "@"
+  tag     = "issue"
+  ttl     = 3600
+  type    = "CAA"
+  zone_id = xelon_dns_zone.test.id
+}
+
+resource "xelon_dns_record" "mx" {
+  content  = "mail.example.com"
+  name     = "@"
+  priority = %[2]d
+  ttl      = 3600
+  type     = "MX"
+  zone_id  = xelon_dns_zone.test.id
+}
+
+resource "xelon_dns_record" "srv" {
+  content  = "sip.example.com"
+  name     = "_sip._tcp"
+  port     = 5060
+  priority = 10
+  ttl      = 3600
+  type     = "SRV"
+  weight   = 5
+  zone_id  = xelon_dns_zone.test.id
+}
+
+resource "xelon_dns_zone" "test" {
+  name = %[1]q
+}
+`, dnsZoneName, mxPriority)
+}
@@ -0,0 +1,687 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/Xelon-AG/xelon-sdk-go/xelon"
+)
+
+var (
+	_ resource.Resource                   = (*dnsRecordsResource)(nil)
+	_ resource.ResourceWithConfigure      = (*dnsRecordsResource)(nil)
+	_ resource.ResourceWithImportState    = (*dnsRecordsResource)(nil)
+	_ resource.ResourceWithIdentity       = (*dnsRecordsResource)(nil)
+	_ resource.ResourceWithValidateConfig = (*dnsRecordsResource)(nil)
+)
+
+// dnsRecordsResource is the dns records resource implementation.
+type dnsRecordsResource struct {
+	withReadOnlyMode
+
+	client *xelon.Client
+}
+
+// dnsRecordsResourceModel maps the dns records resource schema data.
+type dnsRecordsResourceModel struct {
+	Authoritative types.Bool   `tfsdk:"authoritative"`
+	ID            types.String `tfsdk:"id"`
+	Records       types.Set    `tfsdk:"records"` // []dnsRecordsItemResourceModel
+	ZoneID        types.String `tfsdk:"zone_id"`
+}
+
+// dnsRecordsResourceIdentityModel maps the dns records resource identity schema data.
+type dnsRecordsResourceIdentityModel struct {
+	ZoneID types.String `tfsdk:"zone_id"`
+}
+
+type dnsRecordsItemResourceModel struct {
+	Content  types.String `tfsdk:"content"`
+	Flags    types.Int64  `tfsdk:"flags"`
+	Name     types.String `tfsdk:"name"`
+	Port     types.Int64  `tfsdk:"port"`
+	Priority types.Int64  `tfsdk:"priority"`
+	Tag      types.String `tfsdk:"tag"`
+	TTL      types.Int64  `tfsdk:"ttl"`
+	Type     types.String `tfsdk:"type"`
+	Weight   types.Int64  `tfsdk:"weight"`
+}
+
+// dnsRecordsUpdate pairs an existing backend record with its desired values.
+type dnsRecordsUpdate struct {
+	Record  xelon.DNSRecord
+	Desired dnsRecordsItemResourceModel
+}
+
+func NewDNSRecordsResource() resource.Resource {
+	return &dnsRecordsResource{}
+}
+
+func (r *dnsRecordsResource) Metadata(_ context.Context, _ resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "xelon_dns_records"
+}
+
+func (r *dnsRecordsResource) Schema(_ context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		MarkdownDescription: `
+The DNS records resource allows you to manage many DNS records of a Xelon DNS zone at once.
+
+All changes are calculated against a single listing of the zone records. In authoritative mode, records in the zone
+which are not part of the configuration are removed. The SOA record and the NS records of the zone apex are never
+managed by this resource, as well as records of types not supported by ` + "`xelon_dns_record`" + `.
+`,
+		Version: 0,
+		Attributes: map[string]schema.Attribute{
+			"authoritative": schema.BoolAttribute{
+				MarkdownDescription: "Whether to remove all records of the zone which are not defined in `records`. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the DNS zone.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"records": schema.SetNestedAttribute{
+				MarkdownDescription: "The DNS records of the zone.",
+				Required:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"content": schema.StringAttribute{
+							MarkdownDescription: "DNS record content/value, such as an IP address, hostname, or TXT value. " +
+								"For `MX` records this is the mail server, for `SRV` records the target host, and for `CAA` records the property value.",
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"flags": schema.Int64Attribute{
+							MarkdownDescription: "CAA record flags, such as `0` or `128`. Required for `CAA` records.",
+							Optional:            true,
+							Validators: []validator.Int64{
+								int64validator.Between(0, 255),
+							},
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: `DNS record name relative to the zone, such as "www", "@", or "_sip._tcp". Do not include the zone name.`,
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"port": schema.Int64Attribute{
+							MarkdownDescription: "SRV record target port. Required for `SRV` records.",
+							Optional:            true,
+							Validators: []validator.Int64{
+								int64validator.Between(0, 65535),
+							},
+						},
+						"priority": schema.Int64Attribute{
+							MarkdownDescription: "Record priority. Required for `MX` and `SRV` records.",
+							Optional:            true,
+							Validators: []validator.Int64{
+								int64validator.Between(0, 65535),
+							},
+						},
+						"tag": schema.StringAttribute{
+							MarkdownDescription: "CAA record property tag. Must be one of `issue`, `issuewild`, or `iodef`. Required for `CAA` records.",
+							Optional:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("issue", "issuewild", "iodef"),
+							},
+						},
+						"ttl": schema.Int64Attribute{
+							MarkdownDescription: "DNS record TTL in seconds.",
+							Required:            true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "DNS record type. Supported types are `A`, `AAAA`, `ALIAS`, `CAA`, `CNAME`, `MX`, `NS`, `PTR`, `SRV`, and `TXT`.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(supportedV0DNSRecordTypes()...),
+							},
+						},
+						"weight": schema.Int64Attribute{
+							MarkdownDescription: "SRV record weight. Required for `SRV` records.",
+							Optional:            true,
+							Validators: []validator.Int64{
+								int64validator.Between(0, 65535),
+							},
+						},
+					},
+				},
+			},
+			"zone_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the DNS zone owning the records.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *dnsRecordsResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, response *resource.IdentitySchemaResponse) {
+	response.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"zone_id": identityschema.StringAttribute{
+				Description:       "ID of the DNS zone owning the records.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *dnsRecordsResource) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	client, ok := request.ProviderData.(*xelon.Client)
+	if !ok {
+		response.Diagnostics.AddError(
+			"Unconfigured Xelon client",
+			"Please report this issue to the provider developers.",
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *dnsRecordsResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	if r.checkReadOnly("create", &response.Diagnostics) {
+		return
+	}
+
+	var data dnsRecordsResourceModel
+
+	// read plan data into the model
+	diags := request.Plan.Get(ctx, &data)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var desired []dnsRecordsItemResourceModel
+	response.Diagnostics.Append(data.Records.ElementsAs(ctx, &desired, false)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	zoneID := data.ZoneID.ValueString()
+	response.Diagnostics.Append(r.applyRecords(ctx, zoneID, desired, nil, data.Authoritative.ValueBool())...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(zoneID)
+
+	diags = response.State.Set(ctx, &data)
+	response.Diagnostics.Append(diags...)
+	setResourceIdentity(ctx, response.Identity, dnsRecordsResourceIdentityModel{ZoneID: data.ZoneID}, &response.Diagnostics)
+}
+
+func (r *dnsRecordsResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data dnsRecordsResourceModel
+
+	// read state data into the model
+	diags := request.State.Get(ctx, &data)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var previous []dnsRecordsItemResourceModel
+	response.Diagnostics.Append(data.Records.ElementsAs(ctx, &previous, false)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	zoneID := data.ZoneID.ValueString()
+	tflog.Debug(ctx, "Getting DNS records", map[string]any{"zone_id": zoneID})
+	records, resp, err := r.client.Domains.ListRecords(ctx, zoneID)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			// if the DNS zone (and included records) is somehow already destroyed, mark as successfully gone
+			response.State.RemoveResource(ctx)
+			return
+		}
+		response.Diagnostics.AddError("Unable to get DNS records", err.Error())
+		return
+	}
+	tflog.Debug(ctx, "Got DNS records", map[string]any{"record_count": len(records)})
+
+	// in authoritative mode and after an import every managed record of the zone
+	// is part of the state, otherwise only the previously known records which
+	// still exist, with the values changed outside of terraform
+	items := make([]dnsRecordsItemResourceModel, 0)
+	if data.Authoritative.ValueBool() || data.Records.IsNull() {
+		for _, record := range filterManagedDNSRecords(records) {
+			items = append(items, dnsRecordsItemFromAPI(&record))
+		}
+	} else {
+		itemKeys := make(map[string]bool)
+		for i, record := range matchPreviousDNSRecords(filterManagedDNSRecords(records), previous) {
+			if record == nil {
+				continue
+			}
+			item := previous[i]
+			if item.key() != dnsRecordKeyFromAPI(record) {
+				item = dnsRecordsItemFromAPI(record)
+			}
+			if !itemKeys[item.key()] {
+				itemKeys[item.key()] = true
+				items = append(items, item)
+			}
+		}
+	}
+
+	recordsValue, diags := types.SetValueFrom(ctx, data.Records.ElementType(ctx), items)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	data.ID = types.StringValue(zoneID)
+	data.Records = recordsValue
+
+	diags = response.State.Set(ctx, &data)
+	response.Diagnostics.Append(diags...)
+	setResourceIdentity(ctx, response.Identity, dnsRecordsResourceIdentityModel{ZoneID: data.ZoneID}, &response.Diagnostics)
+}
+
+func (r *dnsRecordsResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	if r.checkReadOnly("update", &response.Diagnostics) {
+		return
+	}
+
+	var plan, state dnsRecordsResourceModel
+
+	// read plan and state data into the model
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var desired, previous []dnsRecordsItemResourceModel
+	response.Diagnostics.Append(plan.Records.ElementsAs(ctx, &desired, false)...)
+	response.Diagnostics.Append(state.Records.ElementsAs(ctx, &previous, false)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	zoneID := plan.ZoneID.ValueString()
+	response.Diagnostics.Append(r.applyRecords(ctx, zoneID, desired, previous, plan.Authoritative.ValueBool())...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = types.StringValue(zoneID)
+
+	diags := response.State.Set(ctx, &plan)
+	response.Diagnostics.Append(diags...)
+	setResourceIdentity(ctx, response.Identity, dnsRecordsResourceIdentityModel{ZoneID: plan.ZoneID}, &response.Diagnostics)
+}
+
+func (r *dnsRecordsResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	if r.checkReadOnly("delete", &response.Diagnostics) {
+		return
+	}
+
+	var data dnsRecordsResourceModel
+
+	// read state data into the model
+	diags := request.State.Get(ctx, &data)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var previous []dnsRecordsItemResourceModel
+	response.Diagnostics.Append(data.Records.ElementsAs(ctx, &previous, false)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	zoneID := data.ZoneID.ValueString()
+	tflog.Debug(ctx, "Getting DNS records", map[string]any{"zone_id": zoneID})
+	records, resp, err := r.client.Domains.ListRecords(ctx, zoneID)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return
+		}
+		response.Diagnostics.AddError("Unable to get DNS records", err.Error())
+		return
+	}
+	tflog.Debug(ctx, "Got DNS records", map[string]any{"record_count": len(records)})
+
+	// removing all records from the configuration deletes exactly the records known to the state
+	_, _, deletes := planDNSRecordsChanges(filterManagedDNSRecords(records), nil, previous, false)
+	for _, record := range deletes {
+		response.Diagnostics.Append(r.deleteRecord(ctx, zoneID, record)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+}
+
+func (r *dnsRecordsResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	var identity dnsRecordsResourceIdentityModel
+	if request.ID != "" {
+		identity.ZoneID = types.StringValue(request.ID)
+	} else {
+		response.Diagnostics.Append(request.Identity.Get(ctx, &identity)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// records are left unset, so the next read adopts all managed records of the zone
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("authoritative"), types.BoolValue(false))...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("id"), identity.ZoneID)...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("zone_id"), identity.ZoneID)...)
+	setResourceIdentity(ctx, response.Identity, identity, &response.Diagnostics)
+}
+
+func (r *dnsRecordsResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var data dnsRecordsResourceModel
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	if data.Records.IsNull() || data.Records.IsUnknown() {
+		return
+	}
+
+	for _, element := range data.Records.Elements() {
+		object, ok := element.(types.Object)
+		if !ok || object.IsUnknown() {
+			continue
+		}
+		attributes := object.Attributes()
+		recordType, ok := attributes["type"].(types.String)
+		if !ok || recordType.IsNull() || recordType.IsUnknown() {
+			continue
+		}
+
+		response.Diagnostics.Append(validateDNSRecordStructuredAttributes(
+			recordType.ValueString(),
+			map[string]attr.Value{
+				"flags":    attributes["flags"],
+				"port":     attributes["port"],
+				"priority": attributes["priority"],
+				"tag":      attributes["tag"],
+				"weight":   attributes["weight"],
+			},
+			path.Root("records").AtSetValue(object),
+		)...)
+	}
+}
+
+// applyRecords reconciles the zone records with the desired records based on a
+// single listing of the zone. Records which are neither desired nor part of
+// previous are only removed in authoritative mode.
+func (r *dnsRecordsResource) applyRecords(ctx context.Context, zoneID string, desired, previous []dnsRecordsItemResourceModel, authoritative bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	tflog.Debug(ctx, "Getting DNS records", map[string]any{"zone_id": zoneID})
+	records, _, err := r.client.Domains.ListRecords(ctx, zoneID)
+	if err != nil {
+		diags.AddError("Unable to get DNS records", err.Error())
+		return diags
+	}
+	tflog.Debug(ctx, "Got DNS records", map[string]any{"record_count": len(records)})
+
+	creates, updates, deletes := planDNSRecordsChanges(filterManagedDNSRecords(records), desired, previous, authoritative)
+	tflog.Info(ctx, "Applying DNS record changes", map[string]any{
+		"create_count": len(creates),
+		"delete_count": len(deletes),
+		"update_count": len(updates),
+		"zone_id":      zoneID,
+	})
+
+	for _, update := range updates {
+		updateRequest := &xelon.DNSRecordUpdateRequest{
+			Host:   update.Desired.Name.ValueString(),
+			Record: buildDNSRecordContent(update.Desired.Type.ValueString(), update.Desired.content()),
+			TTL:    int(update.Desired.TTL.ValueInt64()),
+			Type:   xelon.DNSRecordType(update.Desired.Type.ValueString()),
+		}
+		tflog.Debug(ctx, "Updating DNS record", map[string]any{
+			"payload":   updateRequest,
+			"record_id": strconv.Itoa(update.Record.ID),
+			"zone_id":   zoneID,
+		})
+		_, err := r.client.Domains.UpdateRecord(ctx, zoneID, update.Record.ID, updateRequest)
+		if err != nil {
+			diags.AddError("Unable to update DNS record", err.Error())
+			return diags
+		}
+	}
+
+	for _, item := range creates {
+		createRequest := &xelon.DNSRecordCreateRequest{
+			Host:   item.Name.ValueString(),
+			Record: buildDNSRecordContent(item.Type.ValueString(), item.content()),
+			TTL:    int(item.TTL.ValueInt64()),
+			Type:   xelon.DNSRecordType(item.Type.ValueString()),
+		}
+		tflog.Debug(ctx, "Creating DNS record", map[string]any{"payload": createRequest, "zone_id": zoneID})
+		_, err := r.client.Domains.CreateRecord(ctx, zoneID, createRequest)
+		if err != nil {
+			diags.AddError("Unable to create DNS record", err.Error())
+			return diags
+		}
+	}
+
+	for _, record := range deletes {
+		diags.Append(r.deleteRecord(ctx, zoneID, record)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	return diags
+}
+
+func (r *dnsRecordsResource) deleteRecord(ctx context.Context, zoneID string, record xelon.DNSRecord) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	tflog.Debug(ctx, "Deleting DNS record", map[string]any{
+		"name":      record.Host,
+		"record_id": strconv.Itoa(record.ID),
+		"type":      string(record.Type),
+		"zone_id":   zoneID,
+	})
+	resp, err := r.client.Domains.DeleteRecord(ctx, zoneID, record.ID)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return diags
+		}
+		diags.AddError("Unable to delete DNS record", err.Error())
+	}
+
+	return diags
+}
+
+func (m dnsRecordsItemResourceModel) content() dnsRecordContent {
+	return dnsRecordContent{
+		Content:  m.Content.ValueString(),
+		Flags:    m.Flags.ValueInt64(),
+		Port:     m.Port.ValueInt64(),
+		Priority: m.Priority.ValueInt64(),
+		Tag:      m.Tag.ValueString(),
+		Weight:   m.Weight.ValueInt64(),
+	}
+}
+
+// key identifies a record by all of its values, as the backend record ID is
+// not part of the state.
+func (m dnsRecordsItemResourceModel) key() string {
+	recordType := m.Type.ValueString()
+	return buildDNSRecordKey(m.Name.ValueString(), recordType, m.TTL.ValueInt64(), buildDNSRecordContent(recordType, m.content()))
+}
+
+func dnsRecordsItemFromAPI(record *xelon.DNSRecord) dnsRecordsItemResourceModel {
+	var model dnsRecordResourceModel
+	model.fromAPI(record, "")
+
+	return dnsRecordsItemResourceModel{
+		Content:  model.Content,
+		Flags:    model.Flags,
+		Name:     model.Name,
+		Port:     model.Port,
+		Priority: model.Priority,
+		Tag:      model.Tag,
+		TTL:      model.TTL,
+		Type:     model.Type,
+		Weight:   model.Weight,
+	}
+}
+
+func dnsRecordKeyFromAPI(record *xelon.DNSRecord) string {
+	recordType := string(record.Type)
+	value := record.Record
+	// normalize the record value, e.g. whitespace or quoting
+	if content, ok := parseDNSRecordContent(recordType, record.Record); ok {
+		value = buildDNSRecordContent(recordType, content)
+	}
+
+	return buildDNSRecordKey(record.Host, recordType, int64(record.TTL), value)
+}
+
+func buildDNSRecordKey(name, recordType string, ttl int64, value string) string {
+	return fmt.Sprintf("%s|%s|%d|%s", name, recordType, ttl, value)
+}
+
+// filterManagedDNSRecords drops the records which are never managed by the
+// xelon_dns_records resource: the SOA and apex NS records owned by the zone,
+// and records of unsupported types.
+func filterManagedDNSRecords(records []xelon.DNSRecord) []xelon.DNSRecord {
+	managedRecords := make([]xelon.DNSRecord, 0, len(records))
+	for _, record := range records {
+		if record.Type == xelon.DNSRecordTypeNS && (record.Host == "@" || record.Host == "") {
+			continue
+		}
+		if !slices.Contains(supportedV0DNSRecordTypes(), string(record.Type)) {
+			continue
+		}
+		managedRecords = append(managedRecords, record)
+	}
+
+	return managedRecords
+}
+
+// matchPreviousDNSRecords returns the current record of every previous record,
+// or nil if it does not exist anymore. The records are matched by all of their
+// values first and the remaining ones by name and type, so that records changed
+// outside of terraform are still recognized.
+func matchPreviousDNSRecords(current []xelon.DNSRecord, previous []dnsRecordsItemResourceModel) []*xelon.DNSRecord {
+	matches := make([]*xelon.DNSRecord, len(previous))
+	matched := make([]bool, len(current))
+	match := func(equal func(item dnsRecordsItemResourceModel, record *xelon.DNSRecord) bool) {
+		for i, item := range previous {
+			if matches[i] != nil {
+				continue
+			}
+			for j := range current {
+				if !matched[j] && equal(item, &current[j]) {
+					matches[i] = &current[j]
+					matched[j] = true
+					break
+				}
+			}
+		}
+	}
+
+	match(func(item dnsRecordsItemResourceModel, record *xelon.DNSRecord) bool {
+		return item.key() == dnsRecordKeyFromAPI(record)
+	})
+	match(func(item dnsRecordsItemResourceModel, record *xelon.DNSRecord) bool {
+		return item.Name.ValueString() == record.Host && item.Type.ValueString() == string(record.Type)
+	})
+
+	return matches
+}
+
+// planDNSRecordsChanges calculates which records must be created, updated, and
+// deleted to get from the current to the desired records. A record to delete
+// and a record to create with the same name and type are merged into an update.
+func planDNSRecordsChanges(current []xelon.DNSRecord, desired, previous []dnsRecordsItemResourceModel, authoritative bool) ([]dnsRecordsItemResourceModel, []dnsRecordsUpdate, []xelon.DNSRecord) {
+	currentByKey := make(map[string][]xelon.DNSRecord)
+	for _, record := range current {
+		key := dnsRecordKeyFromAPI(&record)
+		currentByKey[key] = append(currentByKey[key], record)
+	}
+	desiredKeys := make(map[string]bool)
+	for _, item := range desired {
+		desiredKeys[item.key()] = true
+	}
+	previousRecords := make(map[*xelon.DNSRecord]bool)
+	for _, record := range matchPreviousDNSRecords(current, previous) {
+		previousRecords[record] = true
+	}
+
+	var creates []dnsRecordsItemResourceModel
+	for _, item := range desired {
+		if len(currentByKey[item.key()]) == 0 {
+			creates = append(creates, item)
+		}
+	}
+
+	var deletes []xelon.DNSRecord
+	for i := range current {
+		if desiredKeys[dnsRecordKeyFromAPI(&current[i])] {
+			continue
+		}
+		if authoritative || previousRecords[&current[i]] {
+			deletes = append(deletes, current[i])
+		}
+	}
+
+	var remainingCreates []dnsRecordsItemResourceModel
+	var updates []dnsRecordsUpdate
+	for _, item := range creates {
+		index := slices.IndexFunc(deletes, func(record xelon.DNSRecord) bool {
+			return record.Host == item.Name.ValueString() && string(record.Type) == item.Type.ValueString()
+		})
+		if index < 0 {
+			remainingCreates = append(remainingCreates, item)
+			continue
+		}
+		updates = append(updates, dnsRecordsUpdate{Record: deletes[index], Desired: item})
+		deletes = slices.Delete(deletes, index, index+1)
+	}
+
+	return remainingCreates, updates, deletes
+}
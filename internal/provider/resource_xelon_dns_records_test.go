@@ -0,0 +1,444 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Xelon-AG/xelon-sdk-go/xelon"
+)
+
+func testDNSRecordsItem(name, recordType, content string, ttl int64) dnsRecordsItemResourceModel {
+	return dnsRecordsItemResourceModel{
+		Content:  types.StringValue(content),
+		Flags:    types.Int64Null(),
+		Name:     types.StringValue(name),
+		Port:     types.Int64Null(),
+		Priority: types.Int64Null(),
+		Tag:      types.StringNull(),
+		TTL:      types.Int64Value(ttl),
+		Type:     types.StringValue(recordType),
+		Weight:   types.Int64Null(),
+	}
+}
+
+func TestResourceXelonDNSRecords_FilterManagedDNSRecords(t *testing.T) {
+	records := []xelon.DNSRecord{
+		{ID: 1, Host: "@", Type: xelon.DNSRecordTypeNS, Record: "ns1.xelon.ch"},
+		{ID: 2, Host: "", Type: xelon.DNSRecordTypeNS, Record: "ns2.xelon.ch"},
+		{ID: 3, Host: "@", Type: xelon.DNSRecordType("SOA"), Record: "ns1.xelon.ch hostmaster.xelon.ch 1 3600 600 604800 3600"},
+		{ID: 4, Host: "sub", Type: xelon.DNSRecordTypeNS, Record: "ns1.example.net"},
+		{ID: 5, Host: "www", Type: xelon.DNSRecordTypeA, Record: "203.0.113.10"},
+		{ID: 6, Host: "@", Type: xelon.DNSRecordType("DS"), Record: "12345 13 2 abcdef"},
+	}
+
+	managedRecords := filterManagedDNSRecords(records)
+
+	require.Len(t, managedRecords, 2)
+	assert.Equal(t, 4, managedRecords[0].ID)
+	assert.Equal(t, 5, managedRecords[1].ID)
+}
+
+func TestResourceXelonDNSRecords_KeyNormalizesContent(t *testing.T) {
+	item := testDNSRecordsItem("@", "MX", "mail.example.com", 3600)
+	item.Priority = types.Int64Value(10)
+	record := xelon.DNSRecord{Host: "@", Type: xelon.DNSRecordTypeMX, Record: "10   mail.example.com", TTL: 3600}
+
+	assert.Equal(t, item.key(), dnsRecordKeyFromAPI(&record))
+}
+
+func TestResourceXelonDNSRecords_PlanChanges_NoChanges(t *testing.T) {
+	current := []xelon.DNSRecord{
+		{ID: 1, Host: "www", Type: xelon.DNSRecordTypeA, Record: "203.0.113.10", TTL: 3600},
+	}
+	desired := []dnsRecordsItemResourceModel{testDNSRecordsItem("www", "A", "203.0.113.10", 3600)}
+
+	creates, updates, deletes := planDNSRecordsChanges(current, desired, desired, true)
+
+	assert.Empty(t, creates)
+	assert.Empty(t, updates)
+	assert.Empty(t, deletes)
+}
+
+func TestResourceXelonDNSRecords_PlanChanges_CreateAndUpdate(t *testing.T) {
+	current := []xelon.DNSRecord{
+		{ID: 1, Host: "www", Type: xelon.DNSRecordTypeA, Record: "203.0.113.10", TTL: 3600},
+	}
+	previous := []dnsRecordsItemResourceModel{testDNSRecordsItem("www", "A", "203.0.113.10", 3600)}
+	desired := []dnsRecordsItemResourceModel{
+		testDNSRecordsItem("www", "A", "203.0.113.20", 3600),
+		testDNSRecordsItem("api", "CNAME", "www.example.com", 3600),
+	}
+
+	creates, updates, deletes := planDNSRecordsChanges(current, desired, previous, false)
+
+	require.Len(t, creates, 1)
+	assert.Equal(t, "api", creates[0].Name.ValueString())
+	require.Len(t, updates, 1)
+	assert.Equal(t, 1, updates[0].Record.ID)
+	assert.Equal(t, "203.0.113.20", updates[0].Desired.Content.ValueString())
+	assert.Empty(t, deletes)
+}
+
+func TestResourceXelonDNSRecords_PlanChanges_UnmanagedRecords(t *testing.T) {
+	current := []xelon.DNSRecord{
+		{ID: 1, Host: "www", Type: xelon.DNSRecordTypeA, Record: "203.0.113.10", TTL: 3600},
+		{ID: 2, Host: "old", Type: xelon.DNSRecordTypeA, Record: "203.0.113.30", TTL: 3600},
+		{ID: 3, Host: "manual", Type: xelon.DNSRecordTypeTXT, Record: "hand-made", TTL: 3600},
+	}
+	previous := []dnsRecordsItemResourceModel{
+		testDNSRecordsItem("www", "A", "203.0.113.10", 3600),
+		testDNSRecordsItem("old", "A", "203.0.113.30", 3600),
+	}
+	desired := []dnsRecordsItemResourceModel{testDNSRecordsItem("www", "A", "203.0.113.10", 3600)}
+
+	t.Run("non-authoritative", func(t *testing.T) {
+		creates, updates, deletes := planDNSRecordsChanges(current, desired, previous, false)
+
+		assert.Empty(t, creates)
+		assert.Empty(t, updates)
+		require.Len(t, deletes, 1)
+		assert.Equal(t, 2, deletes[0].ID)
+	})
+
+	t.Run("authoritative", func(t *testing.T) {
+		creates, updates, deletes := planDNSRecordsChanges(current, desired, previous, true)
+
+		assert.Empty(t, creates)
+		assert.Empty(t, updates)
+		require.Len(t, deletes, 2)
+		assert.Equal(t, 2, deletes[0].ID)
+		assert.Equal(t, 3, deletes[1].ID)
+	})
+}
+
+func TestResourceXelonDNSRecords_MatchPreviousDNSRecords(t *testing.T) {
+	current := []xelon.DNSRecord{
+		{ID: 1, Host: "www", Type: xelon.DNSRecordTypeA, Record: "203.0.113.10", TTL: 300},
+		{ID: 2, Host: "www", Type: xelon.DNSRecordTypeA, Record: "203.0.113.20", TTL: 3600},
+		{ID: 3, Host: "api", Type: xelon.DNSRecordTypeCNAME, Record: "www.example.com", TTL: 3600},
+	}
+	previous := []dnsRecordsItemResourceModel{
+		testDNSRecordsItem("www", "A", "203.0.113.10", 3600),
+		testDNSRecordsItem("www", "A", "203.0.113.20", 3600),
+		testDNSRecordsItem("old", "A", "203.0.113.30", 3600),
+	}
+
+	matches := matchPreviousDNSRecords(current, previous)
+
+	require.Len(t, matches, 3)
+	require.NotNil(t, matches[0])
+	assert.Equal(t, 1, matches[0].ID, "drifted record is matched by name and type")
+	require.NotNil(t, matches[1])
+	assert.Equal(t, 2, matches[1].ID, "unchanged record is matched by its values")
+	assert.Nil(t, matches[2])
+}
+
+func TestResourceXelonDNSRecords_PlanChanges_DriftedRecord(t *testing.T) {
+	current := []xelon.DNSRecord{
+		{ID: 1, Host: "www", Type: xelon.DNSRecordTypeA, Record: "203.0.113.10", TTL: 300},
+		{ID: 2, Host: "manual", Type: xelon.DNSRecordTypeTXT, Record: "hand-made", TTL: 3600},
+	}
+	previous := []dnsRecordsItemResourceModel{testDNSRecordsItem("www", "A", "203.0.113.10", 3600)}
+	desired := []dnsRecordsItemResourceModel{testDNSRecordsItem("www", "A", "203.0.113.10", 3600)}
+
+	creates, updates, deletes := planDNSRecordsChanges(current, desired, previous, false)
+
+	assert.Empty(t, creates)
+	require.Len(t, updates, 1)
+	assert.Equal(t, 1, updates[0].Record.ID)
+	assert.Equal(t, int64(3600), updates[0].Desired.TTL.ValueInt64())
+	assert.Empty(t, deletes)
+}
+
+func TestResourceXelonDNSRecords_Lifecycle(t *testing.T) {
+	server := newFakeXelonServer(t)
+	server.Mutate(func(s *fakeXelonServer) {
+		s.dnsZones["1"] = &xelon.DNSZone{ID: "1", Name: "example.com"}
+		s.dnsRecords["1"] = []xelon.DNSRecord{
+			{ID: 100, Host: "@", Type: xelon.DNSRecordTypeNS, Record: "ns1.xelon.ch", TTL: 3600},
+			{ID: 101, Host: "@", Type: xelon.DNSRecordType("SOA"), Record: "ns1.xelon.ch hostmaster.xelon.ch 1 3600 600 604800 3600", TTL: 3600},
+			{ID: 102, Host: "legacy", Type: xelon.DNSRecordTypeA, Record: "203.0.113.99", TTL: 3600},
+		}
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// create and read, unmanaged records are kept
+			{
+				Config: server.ProviderConfig() + testResourceXelonDNSRecordsConfig(false, "203.0.113.10", 10),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"xelon_dns_records.test",
+						tfjsonpath.New("id"),
+						knownvalue.StringExact("1"),
+					),
+					statecheck.ExpectKnownValue(
+						"xelon_dns_records.test",
+						tfjsonpath.New("records"),
+						knownvalue.SetSizeExact(2),
+					),
+				},
+				Check: func(_ *terraform.State) error {
+					return testDNSRecordsExpectHosts(server, "1", "@", "@", "legacy", "www", "@")
+				},
+			},
+			// update record values in place
+			{
+				Config: server.ProviderConfig() + testResourceXelonDNSRecordsConfig(false, "203.0.113.20", 20),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("xelon_dns_records.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: func(_ *terraform.State) error {
+					return testDNSRecordsExpectHosts(server, "1", "@", "@", "legacy", "www", "@")
+				},
+			},
+			// authoritative mode removes unmanaged records, but never the NS and SOA records of the zone apex
+			{
+				Config: server.ProviderConfig() + testResourceXelonDNSRecordsConfig(true, "203.0.113.20", 20),
+				Check: func(_ *terraform.State) error {
+					return testDNSRecordsExpectHosts(server, "1", "@", "@", "www", "@")
+				},
+			},
+			// record added outside of terraform is detected and removed in authoritative mode
+			{
+				PreConfig: func() {
+					server.Mutate(func(s *fakeXelonServer) {
+						s.dnsRecords["1"] = append(s.dnsRecords["1"], xelon.DNSRecord{
+							ID: 200, Host: "manual", Type: xelon.DNSRecordTypeTXT, Record: "hand-made", TTL: 300,
+						})
+					})
+				},
+				Config: server.ProviderConfig() + testResourceXelonDNSRecordsConfig(true, "203.0.113.20", 20),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("xelon_dns_records.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: func(_ *terraform.State) error {
+					return testDNSRecordsExpectHosts(server, "1", "@", "@", "www", "@")
+				},
+			},
+		},
+		CheckDestroy: func(_ *terraform.State) error {
+			return testDNSRecordsExpectHosts(server, "1", "@", "@")
+		},
+	})
+}
+
+func TestResourceXelonDNSRecords_Drift(t *testing.T) {
+	server := newFakeXelonServer(t)
+	server.Mutate(func(s *fakeXelonServer) {
+		s.dnsZones["1"] = &xelon.DNSZone{ID: "1", Name: "example.com"}
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + testResourceXelonDNSRecordsConfig(false, "203.0.113.10", 10),
+			},
+			// record changed outside of terraform is updated in place instead of duplicated
+			{
+				PreConfig: func() {
+					server.Mutate(func(s *fakeXelonServer) {
+						for i, record := range s.dnsRecords["1"] {
+							if record.Host == "www" {
+								s.dnsRecords["1"][i].TTL = 300
+							}
+						}
+					})
+				},
+				Config: server.ProviderConfig() + testResourceXelonDNSRecordsConfig(false, "203.0.113.10", 10),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("xelon_dns_records.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: func(_ *terraform.State) error {
+					var err error
+					server.Mutate(func(s *fakeXelonServer) {
+						for _, record := range s.dnsRecords["1"] {
+							if record.Host == "www" && record.TTL != 3600 {
+								err = fmt.Errorf("expected DNS record www to have TTL 3600, got %d", record.TTL)
+							}
+						}
+					})
+					if err != nil {
+						return err
+					}
+					return testDNSRecordsExpectHosts(server, "1", "www", "@")
+				},
+			},
+			// state matches the zone records after the update
+			{
+				Config: server.ProviderConfig() + testResourceXelonDNSRecordsConfig(false, "203.0.113.10", 10),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestResourceXelonDNSRecords_EmptyRecords(t *testing.T) {
+	server := newFakeXelonServer(t)
+	server.Mutate(func(s *fakeXelonServer) {
+		s.dnsZones["1"] = &xelon.DNSZone{ID: "1", Name: "example.com"}
+		s.dnsRecords["1"] = []xelon.DNSRecord{
+			{ID: 100, Host: "@", Type: xelon.DNSRecordTypeNS, Record: "ns1.xelon.ch", TTL: 3600},
+			{ID: 102, Host: "legacy", Type: xelon.DNSRecordTypeA, Record: "203.0.113.99", TTL: 3600},
+		}
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// an empty record set is kept as empty set, not as null
+			{
+				Config: server.ProviderConfig() + `
+resource "xelon_dns_records" "test" {
+  authoritative = true
+  zone_id       = "1"
+  records       = []
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"xelon_dns_records.test",
+						tfjsonpath.New("records"),
+						knownvalue.SetSizeExact(0),
+					),
+				},
+				Check: func(_ *terraform.State) error {
+					return testDNSRecordsExpectHosts(server, "1", "@")
+				},
+			},
+		},
+	})
+}
+
+func TestResourceXelonDNSRecords_Import(t *testing.T) {
+	server := newFakeXelonServer(t)
+	server.Mutate(func(s *fakeXelonServer) {
+		s.dnsZones["1"] = &xelon.DNSZone{ID: "1", Name: "example.com"}
+		s.dnsRecords["1"] = []xelon.DNSRecord{
+			{ID: 100, Host: "@", Type: xelon.DNSRecordTypeNS, Record: "ns1.xelon.ch", TTL: 3600},
+			{ID: 101, Host: "@", Type: xelon.DNSRecordType("SOA"), Record: "ns1.xelon.ch hostmaster.xelon.ch 1 3600 600 604800 3600", TTL: 3600},
+		}
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + testResourceXelonDNSRecordsConfig(false, "203.0.113.10", 10),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentity("xelon_dns_records.test", map[string]knownvalue.Check{
+						"zone_id": knownvalue.StringExact("1"),
+					}),
+				},
+			},
+			// the import adopts every managed record of the zone, without the apex NS and SOA records
+			{
+				Config:            server.ProviderConfig() + testResourceXelonDNSRecordsConfig(false, "203.0.113.10", 10),
+				ResourceName:      "xelon_dns_records.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config:          server.ProviderConfig() + testResourceXelonDNSRecordsConfig(false, "203.0.113.10", 10),
+				ResourceName:    "xelon_dns_records.test",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+		},
+	})
+}
+
+func TestResourceXelonDNSRecords_StructuredAttributesValidation(t *testing.T) {
+	server := newFakeXelonServer(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + `
+resource "xelon_dns_records" "test" {
+  zone_id = "1"
+
+  records = [
+    {
+      content = "mail.example.com"
+      name    = "@"
+      ttl     = 3600
+      type    = "MX"
+    },
+  ]
+}
+`,
+				ExpectError: regexp.MustCompile(`Missing required attribute`),
+			},
+		},
+	})
+}
+
+func testDNSRecordsExpectHosts(server *fakeXelonServer, zoneID string, hosts ...string) error {
+	var actualHosts []string
+	server.Mutate(func(s *fakeXelonServer) {
+		for _, record := range s.dnsRecords[zoneID] {
+			actualHosts = append(actualHosts, record.Host)
+		}
+	})
+	slices.Sort(actualHosts)
+	slices.Sort(hosts)
+	if !slices.Equal(actualHosts, hosts) {
+		return fmt.Errorf("expected DNS record hosts %v in zone %s, got %v", hosts, zoneID, actualHosts)
+	}
+	return nil
+}
+
+func testResourceXelonDNSRecordsConfig(authoritative bool, wwwContent string, mxPriority int64) string {
+	return fmt.Sprintf(`
+resource "xelon_dns_records" "test" {
+  authoritative = %[1]t
+  zone_id       = "1"
+
+  records = [
+    {
+      content = %[2]q
+      name    = "www"
+      ttl     = 3600
+      type    = "A"
+    },
+    {
+      content  = "mail.example.com"
+      name     = "@"
+      priority = %[3]d
+      ttl      = 3600
+      type     = "MX"
+    },
+  ]
+}
+`, authoritative, wwwContent, mxPriority)
+}
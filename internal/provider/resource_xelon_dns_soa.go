@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -22,10 +23,13 @@ var (
 	_ resource.Resource                = (*dnsSOAResource)(nil)
 	_ resource.ResourceWithConfigure   = (*dnsSOAResource)(nil)
 	_ resource.ResourceWithImportState = (*dnsSOAResource)(nil)
+	_ resource.ResourceWithIdentity    = (*dnsSOAResource)(nil)
 )
 
 // dnsSOAResource is the dns SOA settings resource implementation.
 type dnsSOAResource struct {
+	withReadOnlyMode
+
 	client *xelon.Client
 }
 
@@ -41,6 +45,11 @@ type dnsSOAResourceModel struct {
 	ZoneID            types.String `tfsdk:"zone_id"`
 }
 
+// dnsSOAResourceIdentityModel maps the DNS SOA settings resource identity schema data.
+type dnsSOAResourceIdentityModel struct {
+	ZoneID types.String `tfsdk:"zone_id"`
+}
+
 func NewDNSSOAResource() resource.Resource {
 	return &dnsSOAResource{}
 }
@@ -123,6 +132,17 @@ Deleting this Terraform resource removes SOA management from Terraform state onl
 	}
 }
 
+func (r *dnsSOAResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, response *resource.IdentitySchemaResponse) {
+	response.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"zone_id": identityschema.StringAttribute{
+				Description:       "ID of the DNS zone owning these SOA settings.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *dnsSOAResource) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
 	if request.ProviderData == nil {
 		return
@@ -141,6 +161,10 @@ func (r *dnsSOAResource) Configure(_ context.Context, request resource.Configure
 }
 
 func (r *dnsSOAResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	if r.checkReadOnly("create", &response.Diagnostics) {
+		return
+	}
+
 	var data dnsSOAResourceModel
 
 	diags := request.Plan.Get(ctx, &data)
@@ -198,6 +222,7 @@ func (r *dnsSOAResource) Create(ctx context.Context, request resource.CreateRequ
 
 	diags = response.State.Set(ctx, &data)
 	response.Diagnostics.Append(diags...)
+	setResourceIdentity(ctx, response.Identity, dnsSOAResourceIdentityModel{ZoneID: data.ZoneID}, &response.Diagnostics)
 }
 
 func (r *dnsSOAResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
@@ -236,9 +261,14 @@ func (r *dnsSOAResource) Read(ctx context.Context, request resource.ReadRequest,
 
 	diags = response.State.Set(ctx, &data)
 	response.Diagnostics.Append(diags...)
+	setResourceIdentity(ctx, response.Identity, dnsSOAResourceIdentityModel{ZoneID: data.ZoneID}, &response.Diagnostics)
 }
 
 func (r *dnsSOAResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	if r.checkReadOnly("update", &response.Diagnostics) {
+		return
+	}
+
 	var data dnsSOAResourceModel
 
 	diags := request.Plan.Get(ctx, &data)
@@ -296,9 +326,14 @@ func (r *dnsSOAResource) Update(ctx context.Context, request resource.UpdateRequ
 
 	diags = response.State.Set(ctx, &data)
 	response.Diagnostics.Append(diags...)
+	setResourceIdentity(ctx, response.Identity, dnsSOAResourceIdentityModel{ZoneID: data.ZoneID}, &response.Diagnostics)
 }
 
 func (r *dnsSOAResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	if r.checkReadOnly("delete", &response.Diagnostics) {
+		return
+	}
+
 	var data dnsSOAResourceModel
 
 	diags := request.State.Get(ctx, &data)
@@ -321,8 +356,19 @@ func (r *dnsSOAResource) Delete(ctx context.Context, request resource.DeleteRequ
 }
 
 func (r *dnsSOAResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
-	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("id"), request.ID)...)
-	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("zone_id"), request.ID)...)
+	var identity dnsSOAResourceIdentityModel
+	if request.ID != "" {
+		identity.ZoneID = types.StringValue(request.ID)
+	} else {
+		response.Diagnostics.Append(request.Identity.Get(ctx, &identity)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("id"), identity.ZoneID)...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("zone_id"), identity.ZoneID)...)
+	setResourceIdentity(ctx, response.Identity, identity, &response.Diagnostics)
 }
 
 func (m *dnsSOAResourceModel) fromAPI(soa *xelon.DNSSOA, zoneID string) {
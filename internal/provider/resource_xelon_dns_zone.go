@@ -21,10 +21,13 @@ var (
 	_ resource.Resource                = (*dnsZoneResource)(nil)
 	_ resource.ResourceWithConfigure   = (*dnsZoneResource)(nil)
 	_ resource.ResourceWithImportState = (*dnsZoneResource)(nil)
+	_ resource.ResourceWithIdentity    = (*dnsZoneResource)(nil)
 )
 
 // dnsZoneResource is the dns zone resource implementation.
 type dnsZoneResource struct {
+	withReadOnlyMode
+
 	client *xelon.Client
 }
 
@@ -70,6 +73,10 @@ The DNS zone resource allows you to manage DNS zones in Xelon.
 	}
 }
 
+func (r *dnsZoneResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, response *resource.IdentitySchemaResponse) {
+	response.IdentitySchema = idIdentitySchema("The ID of the DNS zone.")
+}
+
 func (r *dnsZoneResource) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
 	if request.ProviderData == nil {
 		return
@@ -88,6 +95,10 @@ func (r *dnsZoneResource) Configure(_ context.Context, request resource.Configur
 }
 
 func (r *dnsZoneResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	if r.checkReadOnly("create", &response.Diagnostics) {
+		return
+	}
+
 	var data dnsZoneResourceModel
 
 	// read plan data into the model
@@ -114,6 +125,7 @@ func (r *dnsZoneResource) Create(ctx context.Context, request resource.CreateReq
 
 	diags = response.State.Set(ctx, &data)
 	response.Diagnostics.Append(diags...)
+	setResourceIdentity(ctx, response.Identity, idResourceIdentityModel{ID: data.ID}, &response.Diagnostics)
 }
 
 func (r *dnsZoneResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
@@ -146,6 +158,7 @@ func (r *dnsZoneResource) Read(ctx context.Context, request resource.ReadRequest
 
 	diags = response.State.Set(ctx, &data)
 	response.Diagnostics.Append(diags...)
+	setResourceIdentity(ctx, response.Identity, idResourceIdentityModel{ID: data.ID}, &response.Diagnostics)
 }
 
 func (r *dnsZoneResource) Update(ctx context.Context, _ resource.UpdateRequest, _ *resource.UpdateResponse) {
@@ -153,6 +166,10 @@ func (r *dnsZoneResource) Update(ctx context.Context, _ resource.UpdateRequest,
 }
 
 func (r *dnsZoneResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	if r.checkReadOnly("delete", &response.Diagnostics) {
+		return
+	}
+
 	var data dnsZoneResourceModel
 
 	// read state data into the model
@@ -173,5 +190,5 @@ func (r *dnsZoneResource) Delete(ctx context.Context, request resource.DeleteReq
 }
 
 func (r *dnsZoneResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), request, response)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), request, response)
 }
@@ -4,12 +4,15 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"net/http"
+	"regexp"
 	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 
@@ -72,6 +75,81 @@ func TestAccResourceXelonDNSZone(t *testing.T) {
 	})
 }
 
+func TestResourceXelonDNSZone_Lifecycle(t *testing.T) {
+	server := newFakeXelonServer(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// create and read
+			{
+				Config: server.ProviderConfig() + testAccResourceXelonDNSZoneConfig("example.com"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"xelon_dns_zone.test",
+						tfjsonpath.New("id"),
+						knownvalue.NotNull(),
+					),
+					statecheck.ExpectKnownValue(
+						"xelon_dns_zone.test",
+						tfjsonpath.New("name"),
+						knownvalue.StringExact("example.com"),
+					),
+				},
+			},
+			// ImportState testing
+			{
+				ResourceName:      "xelon_dns_zone.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// zone deleted outside of terraform is re-created
+			{
+				PreConfig: func() {
+					server.Mutate(func(s *fakeXelonServer) {
+						clear(s.dnsZones)
+					})
+				},
+				Config: server.ProviderConfig() + testAccResourceXelonDNSZoneConfig("example.com"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("xelon_dns_zone.test", plancheck.ResourceActionCreate),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestResourceXelonDNSZone_APIErrors(t *testing.T) {
+	server := newFakeXelonServer(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					server.InjectValidationError(http.MethodPost, "dns/zones", http.StatusUnprocessableEntity, 1, map[string]any{
+						"domain": []any{"The domain has already been taken."},
+					})
+				},
+				Config:      server.ProviderConfig() + testAccResourceXelonDNSZoneConfig("example.com"),
+				ExpectError: regexp.MustCompile(`Unable to create dns zone`),
+			},
+			{
+				Config: server.ProviderConfig() + testAccResourceXelonDNSZoneConfig("example.com"),
+			},
+			{
+				PreConfig: func() {
+					server.InjectError(http.MethodGet, "dns/zones/1", http.StatusInternalServerError, 1)
+				},
+				Config:      server.ProviderConfig() + testAccResourceXelonDNSZoneConfig("example.com"),
+				ExpectError: regexp.MustCompile(`Unable to get dns zone`),
+			},
+		},
+	})
+}
+
 func testAccResourceXelonDNSZoneConfig(name string) string {
 	return fmt.Sprintf(`
 resource "xelon_dns_zone" "test" {
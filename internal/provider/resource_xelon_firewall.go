@@ -3,7 +3,9 @@ package provider
 import (
 	"context"
 	"net/http"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -22,24 +24,34 @@ var (
 	_ resource.Resource                = (*firewallResource)(nil)
 	_ resource.ResourceWithConfigure   = (*firewallResource)(nil)
 	_ resource.ResourceWithImportState = (*firewallResource)(nil)
+	_ resource.ResourceWithIdentity    = (*firewallResource)(nil)
+	_ resourceWithProviderDefaults     = (*firewallResource)(nil)
+)
+
+const (
+	defaultFirewallTimeout = 10 * time.Minute
 )
 
 // firewallResource is the firewall resource implementation.
 type firewallResource struct {
+	withProviderDefaults
+	withReadOnlyMode
+
 	client *xelon.Client
 }
 
 // firewallResourceModel maps the firewall resource schema data.
 type firewallResourceModel struct {
-	CloudID             types.String `tfsdk:"cloud_id"`
-	ExternalIPAddress   types.String `tfsdk:"external_ipv4_address"`
-	ExternalIPAddressID types.String `tfsdk:"external_ipv4_address_id"`
-	ExternalNetworkID   types.String `tfsdk:"external_network_id"`
-	ID                  types.String `tfsdk:"id"`
-	InternalIPAddress   types.String `tfsdk:"internal_ipv4_address"`
-	InternalNetworkID   types.String `tfsdk:"internal_network_id"`
-	Name                types.String `tfsdk:"name"`
-	TenantID            types.String `tfsdk:"tenant_id"`
+	CloudID             types.String   `tfsdk:"cloud_id"`
+	ExternalIPAddress   types.String   `tfsdk:"external_ipv4_address"`
+	ExternalIPAddressID types.String   `tfsdk:"external_ipv4_address_id"`
+	ExternalNetworkID   types.String   `tfsdk:"external_network_id"`
+	ID                  types.String   `tfsdk:"id"`
+	InternalIPAddress   types.String   `tfsdk:"internal_ipv4_address"`
+	InternalNetworkID   types.String   `tfsdk:"internal_network_id"`
+	Name                types.String   `tfsdk:"name"`
+	TenantID            types.String   `tfsdk:"tenant_id"`
+	Timeouts            timeouts.Value `tfsdk:"timeouts"`
 }
 
 func NewFirewallResource() resource.Resource {
@@ -50,7 +62,7 @@ func (r *firewallResource) Metadata(_ context.Context, _ resource.MetadataReques
 	response.TypeName = "xelon_firewall"
 }
 
-func (r *firewallResource) Schema(_ context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+func (r *firewallResource) Schema(ctx context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
 	response.Schema = schema.Schema{
 		MarkdownDescription: `
 The firewall resource allows you to manage Xelon firewalls.
@@ -58,8 +70,12 @@ The firewall resource allows you to manage Xelon firewalls.
 		Version: 0,
 		Attributes: map[string]schema.Attribute{
 			"cloud_id": schema.StringAttribute{
-				MarkdownDescription: "The ID of the cloud associated with the firewall.",
-				Required:            true,
+				MarkdownDescription: "The ID of the cloud associated with the firewall. Defaults to the provider `default_cloud_id`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					r.cloudIDPlanModifier(true),
+				},
 			},
 			"external_ipv4_address": schema.StringAttribute{
 				MarkdownDescription: "The external IP address of the firewall.",
@@ -113,13 +129,29 @@ The firewall resource allows you to manage Xelon firewalls.
 				Required:            true,
 			},
 			"tenant_id": schema.StringAttribute{
-				MarkdownDescription: "The tenant ID to whom the firewall belongs.",
-				Required:            true,
+				MarkdownDescription: "The tenant ID to whom the firewall belongs. Defaults to the provider `default_tenant_id`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					r.tenantIDPlanModifier(true),
+				},
 			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create:            true,
+				CreateDescription: "Applies to each step waiting for the resource. Defaults to 10m.",
+				Update:            true,
+				UpdateDescription: "Defaults to 10m.",
+				Delete:            true,
+				DeleteDescription: "Defaults to 10m.",
+			}),
 		},
 	}
 }
 
+func (r *firewallResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, response *resource.IdentitySchemaResponse) {
+	response.IdentitySchema = idIdentitySchema("The ID of the firewall.")
+}
+
 func (r *firewallResource) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
 	if request.ProviderData == nil {
 		return
@@ -138,6 +170,10 @@ func (r *firewallResource) Configure(_ context.Context, request resource.Configu
 }
 
 func (r *firewallResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	if r.checkReadOnly("create", &response.Diagnostics) {
+		return
+	}
+
 	var data firewallResourceModel
 
 	// read plan data into the model
@@ -147,6 +183,13 @@ func (r *firewallResource) Create(ctx context.Context, request resource.CreateRe
 		return
 	}
 
+	// configure timeout
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultFirewallTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	createRequest := &xelon.FirewallCreateRequest{
 		CloudID:           data.CloudID.ValueString(),
 		InternalNetworkID: data.InternalNetworkID.ValueString(),
@@ -173,7 +216,7 @@ func (r *firewallResource) Create(ctx context.Context, request resource.CreateRe
 	firewallID := createdFirewall.ID
 
 	tflog.Info(ctx, "Waiting for firewall to be ready", map[string]any{"firewall_id": firewallID})
-	err = helper.WaitFirewallStateReady(ctx, r.client, firewallID)
+	err = helper.WaitFirewallStateReady(ctx, r.client, firewallID, createTimeout)
 	if err != nil {
 		response.Diagnostics.AddError("Unable to wait for firewall to be ready", err.Error())
 		return
@@ -198,6 +241,7 @@ func (r *firewallResource) Create(ctx context.Context, request resource.CreateRe
 
 	diags = response.State.Set(ctx, &data)
 	response.Diagnostics.Append(diags...)
+	setResourceIdentity(ctx, response.Identity, idResourceIdentityModel{ID: data.ID}, &response.Diagnostics)
 }
 
 func (r *firewallResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
@@ -234,9 +278,14 @@ func (r *firewallResource) Read(ctx context.Context, request resource.ReadReques
 
 	diags = response.State.Set(ctx, &data)
 	response.Diagnostics.Append(diags...)
+	setResourceIdentity(ctx, response.Identity, idResourceIdentityModel{ID: data.ID}, &response.Diagnostics)
 }
 
 func (r *firewallResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	if r.checkReadOnly("update", &response.Diagnostics) {
+		return
+	}
+
 	var plan, state firewallResourceModel
 
 	// read plan and state data into the model
@@ -246,6 +295,15 @@ func (r *firewallResource) Update(ctx context.Context, request resource.UpdateRe
 		return
 	}
 
+	// configure timeout
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultFirewallTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	firewallID := state.ID.ValueString()
 
 	if !plan.Name.Equal(state.Name) {
@@ -271,11 +329,16 @@ func (r *firewallResource) Update(ctx context.Context, request resource.UpdateRe
 		plan.Name = types.StringValue(firewall.Name)
 	}
 
-	diags := response.State.Set(ctx, &plan)
+	diags = response.State.Set(ctx, &plan)
 	response.Diagnostics.Append(diags...)
+	setResourceIdentity(ctx, response.Identity, idResourceIdentityModel{ID: plan.ID}, &response.Diagnostics)
 }
 
 func (r *firewallResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	if r.checkReadOnly("delete", &response.Diagnostics) {
+		return
+	}
+
 	var data firewallResourceModel
 
 	// read state data into the model
@@ -285,6 +348,15 @@ func (r *firewallResource) Delete(ctx context.Context, request resource.DeleteRe
 		return
 	}
 
+	// configure timeout
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultFirewallTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	firewallID := data.ID.ValueString()
 	tflog.Debug(ctx, "Deleting firewall", map[string]any{"firewall_id": firewallID})
 	_, err := r.client.Firewalls.Delete(ctx, firewallID)
@@ -296,5 +368,5 @@ func (r *firewallResource) Delete(ctx context.Context, request resource.DeleteRe
 }
 
 func (r *firewallResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), request, response)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), request, response)
 }
@@ -2,12 +2,16 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -15,16 +19,21 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
+	"github.com/Xelon-AG/terraform-provider-xelon/internal/provider/helper"
 	"github.com/Xelon-AG/xelon-sdk-go/xelon"
 )
 
 var (
-	_ resource.Resource              = (*firewallForwardingRuleResource)(nil)
-	_ resource.ResourceWithConfigure = (*firewallForwardingRuleResource)(nil)
+	_ resource.Resource                = (*firewallForwardingRuleResource)(nil)
+	_ resource.ResourceWithConfigure   = (*firewallForwardingRuleResource)(nil)
+	_ resource.ResourceWithImportState = (*firewallForwardingRuleResource)(nil)
+	_ resource.ResourceWithIdentity    = (*firewallForwardingRuleResource)(nil)
 )
 
 // firewallForwardingRuleResource is the firewall forwarding rule resource implementation.
 type firewallForwardingRuleResource struct {
+	withReadOnlyMode
+
 	client *xelon.Client
 }
 
@@ -40,6 +49,12 @@ type firewallForwardingRuleResourceModel struct {
 	Type                   types.String `tfsdk:"type"`
 }
 
+// firewallForwardingRuleResourceIdentityModel maps the firewall forwarding rule resource identity schema data.
+type firewallForwardingRuleResourceIdentityModel struct {
+	FirewallID types.String `tfsdk:"firewall_id"`
+	ID         types.String `tfsdk:"id"`
+}
+
 func NewFirewallForwardingRuleResource() resource.Resource {
 	return &firewallForwardingRuleResource{}
 }
@@ -121,6 +136,21 @@ A forwarding rules specifies how to route network traffic to the Devices.
 	}
 }
 
+func (r *firewallForwardingRuleResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, response *resource.IdentitySchemaResponse) {
+	response.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"firewall_id": identityschema.StringAttribute{
+				Description:       "The ID of the firewall.",
+				RequiredForImport: true,
+			},
+			"id": identityschema.StringAttribute{
+				Description:       "The ID of the forwarding rule.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *firewallForwardingRuleResource) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
 	if request.ProviderData == nil {
 		return
@@ -139,6 +169,10 @@ func (r *firewallForwardingRuleResource) Configure(_ context.Context, request re
 }
 
 func (r *firewallForwardingRuleResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	if r.checkReadOnly("create", &response.Diagnostics) {
+		return
+	}
+
 	var data firewallForwardingRuleResourceModel
 
 	// read plan data into the model
@@ -203,6 +237,7 @@ func (r *firewallForwardingRuleResource) Create(ctx context.Context, request res
 
 	diags = response.State.Set(ctx, &data)
 	response.Diagnostics.Append(diags...)
+	setResourceIdentity(ctx, response.Identity, firewallForwardingRuleResourceIdentityModel{FirewallID: data.FirewallID, ID: data.ID}, &response.Diagnostics)
 }
 
 func (r *firewallForwardingRuleResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
@@ -217,7 +252,7 @@ func (r *firewallForwardingRuleResource) Read(ctx context.Context, request resou
 
 	firewallID := data.FirewallID.ValueString()
 	tflog.Debug(ctx, "Getting firewall with forwarding rules", map[string]any{"firewall_id": firewallID})
-	firewall, resp, err := r.client.Firewalls.Get(ctx, firewallID)
+	firewall, resp, err := r.client.Firewalls.Get(helper.WithReadCache(ctx), firewallID)
 	if err != nil {
 		if resp != nil && resp.StatusCode == http.StatusNotFound {
 			// if the firewall (and included forwarding rules) is somehow already destroyed, mark as successfully gone
@@ -229,14 +264,7 @@ func (r *firewallForwardingRuleResource) Read(ctx context.Context, request resou
 	}
 	tflog.Debug(ctx, "Got firewall with forwarding rules", map[string]any{"data": firewall})
 
-	var forwardingRule *xelon.FirewallForwardingRule
-	forwardingRuleID := data.ID.ValueString()
-	for _, fForwardingRule := range firewall.ForwardingRules {
-		if fForwardingRule.ID == forwardingRuleID {
-			forwardingRule = &fForwardingRule
-			break
-		}
-	}
+	forwardingRule := findFirewallForwardingRuleByID(firewall.ForwardingRules, data.ID.ValueString())
 	if forwardingRule == nil {
 		// if the forwarding rule is somehow already destroyed, mark as successfully gone
 		response.State.RemoveResource(ctx)
@@ -244,34 +272,19 @@ func (r *firewallForwardingRuleResource) Read(ctx context.Context, request resou
 	}
 
 	// map response body to attributes
-	destinationIPAddresses := make([]string, 0, len(forwardingRule.DestinationIPAddresses))
-	destinationIPAddresses = append(destinationIPAddresses, forwardingRule.DestinationIPAddresses...)
-	data.SourceIPAddresses, diags = types.SetValueFrom(ctx, types.StringType, destinationIPAddresses)
-	response.Diagnostics.Append(diags...)
-	sourceIPAddresses := make([]string, 0, len(forwardingRule.SourceIPAddresses))
-	sourceIPAddresses = append(sourceIPAddresses, forwardingRule.SourceIPAddresses...)
-	data.SourceIPAddresses, diags = types.SetValueFrom(ctx, types.StringType, sourceIPAddresses)
+	diags = data.fromAPI(ctx, firewallID, forwardingRule)
 	response.Diagnostics.Append(diags...)
 
-	if forwardingRule.Type == "inbound" {
-		data.FromPort = types.Int64Value(int64(forwardingRule.ExternalPort))
-		data.ToPort = types.Int64Value(int64(forwardingRule.InternalPort))
-	}
-	if forwardingRule.Type == "outbound" {
-		data.FromPort = types.Int64Value(int64(forwardingRule.InternalPort))
-		data.ToPort = types.Int64Value(int64(forwardingRule.ExternalPort))
-	}
-
-	data.FirewallID = types.StringValue(firewallID)
-	data.ID = types.StringValue(forwardingRule.ID)
-	data.Protocol = types.StringValue(forwardingRule.Protocol)
-	data.Type = types.StringValue(forwardingRule.Type)
-
 	diags = response.State.Set(ctx, &data)
 	response.Diagnostics.Append(diags...)
+	setResourceIdentity(ctx, response.Identity, firewallForwardingRuleResourceIdentityModel{FirewallID: data.FirewallID, ID: data.ID}, &response.Diagnostics)
 }
 
 func (r *firewallForwardingRuleResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	if r.checkReadOnly("update", &response.Diagnostics) {
+		return
+	}
+
 	var data firewallForwardingRuleResourceModel
 
 	// read plan data into the model
@@ -341,34 +354,19 @@ func (r *firewallForwardingRuleResource) Update(ctx context.Context, request res
 	})
 
 	// map response body to attributes
-	destinationIPAddresses = make([]string, 0, len(forwardingRule.DestinationIPAddresses))
-	destinationIPAddresses = append(destinationIPAddresses, forwardingRule.DestinationIPAddresses...)
-	data.SourceIPAddresses, diags = types.SetValueFrom(ctx, types.StringType, destinationIPAddresses)
+	diags = data.fromAPI(ctx, firewallID, forwardingRule)
 	response.Diagnostics.Append(diags...)
-	sourceIPAddresses = make([]string, 0, len(forwardingRule.SourceIPAddresses))
-	sourceIPAddresses = append(sourceIPAddresses, forwardingRule.SourceIPAddresses...)
-	data.SourceIPAddresses, diags = types.SetValueFrom(ctx, types.StringType, sourceIPAddresses)
-	response.Diagnostics.Append(diags...)
-
-	if forwardingRule.Type == "inbound" {
-		data.FromPort = types.Int64Value(int64(forwardingRule.ExternalPort))
-		data.ToPort = types.Int64Value(int64(forwardingRule.InternalPort))
-	}
-	if forwardingRule.Type == "outbound" {
-		data.FromPort = types.Int64Value(int64(forwardingRule.InternalPort))
-		data.ToPort = types.Int64Value(int64(forwardingRule.ExternalPort))
-	}
-
-	data.FirewallID = types.StringValue(firewallID)
-	data.ID = types.StringValue(forwardingRule.ID)
-	data.Protocol = types.StringValue(forwardingRule.Protocol)
-	data.Type = types.StringValue(forwardingRule.Type)
 
 	diags = response.State.Set(ctx, &data)
 	response.Diagnostics.Append(diags...)
+	setResourceIdentity(ctx, response.Identity, firewallForwardingRuleResourceIdentityModel{FirewallID: data.FirewallID, ID: data.ID}, &response.Diagnostics)
 }
 
 func (r *firewallForwardingRuleResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	if r.checkReadOnly("delete", &response.Diagnostics) {
+		return
+	}
+
 	var data firewallForwardingRuleResourceModel
 
 	// read state data into the model
@@ -394,3 +392,108 @@ func (r *firewallForwardingRuleResource) Delete(ctx context.Context, request res
 		"forwarding_rule_id": forwardingRuleID,
 	})
 }
+
+func (r *firewallForwardingRuleResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	var identity firewallForwardingRuleResourceIdentityModel
+	if request.ID != "" {
+		firewallID, forwardingRuleID, err := parseForwardingRuleCompositeID(request.ID, "firewall_id")
+		if err != nil {
+			response.Diagnostics.AddError("Invalid import identifier", err.Error())
+			return
+		}
+		identity = firewallForwardingRuleResourceIdentityModel{FirewallID: types.StringValue(firewallID), ID: types.StringValue(forwardingRuleID)}
+	} else {
+		response.Diagnostics.Append(request.Identity.Get(ctx, &identity)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+	firewallID := identity.FirewallID.ValueString()
+	forwardingRuleID := identity.ID.ValueString()
+
+	tflog.Debug(ctx, "Getting firewall with forwarding rules", map[string]any{"firewall_id": firewallID})
+	firewall, resp, err := r.client.Firewalls.Get(helper.WithReadCache(ctx), firewallID)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			response.Diagnostics.AddError(
+				"Unable to import forwarding rule",
+				fmt.Sprintf("Firewall %s does not exist.", firewallID),
+			)
+			return
+		}
+		response.Diagnostics.AddError("Unable to get firewall with forwarding rules", err.Error())
+		return
+	}
+	tflog.Debug(ctx, "Got firewall with forwarding rules", map[string]any{"data": firewall})
+
+	forwardingRule := findFirewallForwardingRuleByID(firewall.ForwardingRules, forwardingRuleID)
+	if forwardingRule == nil {
+		response.Diagnostics.AddError(
+			"Unable to import forwarding rule",
+			fmt.Sprintf("Forwarding rule %s does not exist in firewall %s.", forwardingRuleID, firewallID),
+		)
+		return
+	}
+
+	var data firewallForwardingRuleResourceModel
+	diags := data.fromAPI(ctx, firewallID, forwardingRule)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	diags = response.State.Set(ctx, &data)
+	response.Diagnostics.Append(diags...)
+	setResourceIdentity(ctx, response.Identity, identity, &response.Diagnostics)
+}
+
+func (m *firewallForwardingRuleResourceModel) fromAPI(ctx context.Context, firewallID string, forwardingRule *xelon.FirewallForwardingRule) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	destinationIPAddresses := make([]string, 0, len(forwardingRule.DestinationIPAddresses))
+	destinationIPAddresses = append(destinationIPAddresses, forwardingRule.DestinationIPAddresses...)
+	destinationIPAddressesValue, d := types.SetValueFrom(ctx, types.StringType, destinationIPAddresses)
+	diags.Append(d...)
+	sourceIPAddresses := make([]string, 0, len(forwardingRule.SourceIPAddresses))
+	sourceIPAddresses = append(sourceIPAddresses, forwardingRule.SourceIPAddresses...)
+	sourceIPAddressesValue, d := types.SetValueFrom(ctx, types.StringType, sourceIPAddresses)
+	diags.Append(d...)
+
+	if forwardingRule.Type == "inbound" {
+		m.FromPort = types.Int64Value(int64(forwardingRule.ExternalPort))
+		m.ToPort = types.Int64Value(int64(forwardingRule.InternalPort))
+	}
+	if forwardingRule.Type == "outbound" {
+		m.FromPort = types.Int64Value(int64(forwardingRule.InternalPort))
+		m.ToPort = types.Int64Value(int64(forwardingRule.ExternalPort))
+	}
+
+	m.DestinationIPAddresses = destinationIPAddressesValue
+	m.FirewallID = types.StringValue(firewallID)
+	m.ID = types.StringValue(forwardingRule.ID)
+	m.Protocol = types.StringValue(forwardingRule.Protocol)
+	m.SourceIPAddresses = sourceIPAddressesValue
+	m.Type = types.StringValue(forwardingRule.Type)
+
+	return diags
+}
+
+func findFirewallForwardingRuleByID(forwardingRules []xelon.FirewallForwardingRule, forwardingRuleID string) *xelon.FirewallForwardingRule {
+	for i := range forwardingRules {
+		if forwardingRules[i].ID == forwardingRuleID {
+			return &forwardingRules[i]
+		}
+	}
+	return nil
+}
+
+// parseForwardingRuleCompositeID splits an import identifier of the form
+// <parent_id>/<rule_id>, where parentAttribute names the parent ID in errors.
+func parseForwardingRuleCompositeID(id, parentAttribute string) (string, string, error) {
+	parentID, forwardingRuleID, ok := strings.Cut(id, "/")
+	if !ok || parentID == "" || forwardingRuleID == "" || strings.Contains(forwardingRuleID, "/") {
+		return "", "", fmt.Errorf("expected format: <%s>/<rule_id>", parentAttribute)
+	}
+
+	return parentID, forwardingRuleID, nil
+}
@@ -0,0 +1,219 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Xelon-AG/xelon-sdk-go/xelon"
+)
+
+func TestResourceXelonFirewallForwardingRule_CompositeID_Parse(t *testing.T) {
+	firewallID, forwardingRuleID, err := parseForwardingRuleCompositeID("fw-123/rule-456", "firewall_id")
+
+	require.NoError(t, err)
+	assert.Equal(t, "fw-123", firewallID)
+	assert.Equal(t, "rule-456", forwardingRuleID)
+}
+
+func TestResourceXelonFirewallForwardingRule_CompositeID_ParseInvalid(t *testing.T) {
+	testCases := []string{
+		"",
+		"fw-123",
+		"/rule-456",
+		"fw-123/",
+		"fw-123/rule-456/extra",
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase, func(t *testing.T) {
+			_, _, err := parseForwardingRuleCompositeID(testCase, "firewall_id")
+			require.EqualError(t, err, "expected format: <firewall_id>/<rule_id>")
+		})
+	}
+}
+
+func TestResourceXelonFirewallForwardingRule_Model_FromAPI(t *testing.T) {
+	testCases := map[string]struct {
+		forwardingRule   *xelon.FirewallForwardingRule
+		expectedFromPort int64
+		expectedToPort   int64
+	}{
+		"inbound": {
+			forwardingRule: &xelon.FirewallForwardingRule{
+				DestinationIPAddresses: []string{"10.0.0.50"},
+				ExternalPort:           443,
+				ID:                     "rule-456",
+				InternalPort:           8080,
+				Protocol:               "tcp",
+				SourceIPAddresses:      []string{"0.0.0.0/0"},
+				Type:                   "inbound",
+			},
+			expectedFromPort: 443,
+			expectedToPort:   8080,
+		},
+		"outbound": {
+			forwardingRule: &xelon.FirewallForwardingRule{
+				DestinationIPAddresses: []string{"0.0.0.0/0"},
+				ExternalPort:           443,
+				ID:                     "rule-456",
+				InternalPort:           8080,
+				Protocol:               "tcp",
+				SourceIPAddresses:      []string{"10.0.0.50"},
+				Type:                   "outbound",
+			},
+			expectedFromPort: 8080,
+			expectedToPort:   443,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var model firewallForwardingRuleResourceModel
+
+			diags := model.fromAPI(context.Background(), "fw-123", testCase.forwardingRule)
+
+			require.False(t, diags.HasError())
+			expectedDestinationIPAddresses, _ := types.SetValueFrom(context.Background(), types.StringType, testCase.forwardingRule.DestinationIPAddresses)
+			expectedSourceIPAddresses, _ := types.SetValueFrom(context.Background(), types.StringType, testCase.forwardingRule.SourceIPAddresses)
+			assert.Equal(t, firewallForwardingRuleResourceModel{
+				DestinationIPAddresses: expectedDestinationIPAddresses,
+				FirewallID:             types.StringValue("fw-123"),
+				FromPort:               types.Int64Value(testCase.expectedFromPort),
+				ID:                     types.StringValue("rule-456"),
+				Protocol:               types.StringValue("tcp"),
+				SourceIPAddresses:      expectedSourceIPAddresses,
+				ToPort:                 types.Int64Value(testCase.expectedToPort),
+				Type:                   types.StringValue(testCase.forwardingRule.Type),
+			}, model)
+		})
+	}
+}
+
+func TestResourceXelonFirewallForwardingRule_Import(t *testing.T) {
+	server := newFakeXelonServer(t)
+	server.Mutate(func(s *fakeXelonServer) {
+		s.firewalls["fw-123"] = &xelon.Firewall{
+			ForwardingRules: []xelon.FirewallForwardingRule{{
+				DestinationIPAddresses: []string{"10.0.0.50"},
+				ExternalPort:           443,
+				ID:                     "rule-456",
+				InternalPort:           8080,
+				Protocol:               "tcp",
+				SourceIPAddresses:      []string{"0.0.0.0/0"},
+				Type:                   "inbound",
+			}},
+			ID:   "fw-123",
+			Name: "imported",
+		}
+	})
+	config := server.ProviderConfig() + testResourceXelonFirewallForwardingRuleConfig("fw-123")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:        config,
+				ResourceName:  "xelon_firewall_forwarding_rule.test",
+				ImportState:   true,
+				ImportStateId: "fw-123",
+				ExpectError:   regexp.MustCompile(`expected format: <firewall_id>/<rule_id>`),
+			},
+			{
+				Config:        config,
+				ResourceName:  "xelon_firewall_forwarding_rule.test",
+				ImportState:   true,
+				ImportStateId: "fw-999/rule-456",
+				ExpectError:   regexp.MustCompile(`Firewall fw-999 does not exist`),
+			},
+			{
+				Config:        config,
+				ResourceName:  "xelon_firewall_forwarding_rule.test",
+				ImportState:   true,
+				ImportStateId: "fw-123/rule-999",
+				ExpectError:   regexp.MustCompile(`Forwarding rule rule-999 does not exist in firewall fw-123`),
+			},
+			{
+				Config:             config,
+				ResourceName:       "xelon_firewall_forwarding_rule.test",
+				ImportState:        true,
+				ImportStateId:      "fw-123/rule-456",
+				ImportStatePersist: true,
+			},
+			// imported state must match the configuration without changes
+			{
+				Config: config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"xelon_firewall_forwarding_rule.test",
+						tfjsonpath.New("destination_ipv4_addresses"),
+						knownvalue.SetExact([]knownvalue.Check{knownvalue.StringExact("10.0.0.50")}),
+					),
+					statecheck.ExpectKnownValue(
+						"xelon_firewall_forwarding_rule.test",
+						tfjsonpath.New("id"),
+						knownvalue.StringExact("rule-456"),
+					),
+				},
+			},
+			// destination addresses changed outside of terraform are detected
+			// and don't overwrite the source addresses
+			{
+				PreConfig: func() {
+					server.Mutate(func(s *fakeXelonServer) {
+						s.firewalls["fw-123"].ForwardingRules[0].DestinationIPAddresses = []string{"10.0.0.60"}
+					})
+				},
+				Config: config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("xelon_firewall_forwarding_rule.test", plancheck.ResourceActionUpdate),
+						plancheck.ExpectKnownValue(
+							"xelon_firewall_forwarding_rule.test",
+							tfjsonpath.New("source_ipv4_addresses"),
+							knownvalue.SetExact([]knownvalue.Check{knownvalue.StringExact("0.0.0.0/0")}),
+						),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"xelon_firewall_forwarding_rule.test",
+						tfjsonpath.New("destination_ipv4_addresses"),
+						knownvalue.SetExact([]knownvalue.Check{knownvalue.StringExact("10.0.0.50")}),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testResourceXelonFirewallForwardingRuleConfig(firewallID string) string {
+	return fmt.Sprintf(`
+resource "xelon_firewall_forwarding_rule" "test" {
+  firewall_id = %[1]q
+
+  protocol = "tcp"
+  type     = "inbound"
+
+  destination_ipv4_addresses = ["10.0.0.50"]
+  source_ipv4_addresses      = ["0.0.0.0/0"]
+  from_port                  = 443
+  to_port                    = 8080
+}
+`, firewallID)
+}
@@ -3,7 +3,9 @@ package provider
 import (
 	"context"
 	"net/http"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -21,23 +23,33 @@ var (
 	_ resource.Resource                = (*isoResource)(nil)
 	_ resource.ResourceWithConfigure   = (*isoResource)(nil)
 	_ resource.ResourceWithImportState = (*isoResource)(nil)
+	_ resource.ResourceWithIdentity    = (*isoResource)(nil)
+	_ resourceWithProviderDefaults     = (*isoResource)(nil)
+)
+
+const (
+	defaultISOTimeout = 10 * time.Minute
 )
 
 // isoResource is the ISO resource implementation.
 type isoResource struct {
+	withProviderDefaults
+	withReadOnlyMode
+
 	client *xelon.Client
 }
 
 // isoResourceModel maps the ISO resource schema data.
 type isoResourceModel struct {
-	Active      types.Bool   `tfsdk:"active"`
-	CategoryID  types.Int64  `tfsdk:"category_id"`
-	CloudID     types.String `tfsdk:"cloud_id"`
-	Description types.String `tfsdk:"description"`
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	TenantID    types.String `tfsdk:"tenant_id"`
-	URL         types.String `tfsdk:"url"`
+	Active      types.Bool     `tfsdk:"active"`
+	CategoryID  types.Int64    `tfsdk:"category_id"`
+	CloudID     types.String   `tfsdk:"cloud_id"`
+	Description types.String   `tfsdk:"description"`
+	ID          types.String   `tfsdk:"id"`
+	Name        types.String   `tfsdk:"name"`
+	TenantID    types.String   `tfsdk:"tenant_id"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
+	URL         types.String   `tfsdk:"url"`
 }
 
 func NewISOResource() resource.Resource {
@@ -48,7 +60,7 @@ func (r *isoResource) Metadata(_ context.Context, _ resource.MetadataRequest, re
 	response.TypeName = "xelon_iso"
 }
 
-func (r *isoResource) Schema(_ context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+func (r *isoResource) Schema(ctx context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
 	response.Schema = schema.Schema{
 		MarkdownDescription: `
 The ISO resource allows you to manage Xelon custom ISOs.
@@ -67,8 +79,12 @@ The ISO resource allows you to manage Xelon custom ISOs.
 				Required:            true,
 			},
 			"cloud_id": schema.StringAttribute{
-				MarkdownDescription: "The ID of the cloud.",
-				Required:            true,
+				MarkdownDescription: "The ID of the cloud. Defaults to the provider `default_cloud_id`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					r.cloudIDPlanModifier(true),
+				},
 			},
 			"description": schema.StringAttribute{
 				MarkdownDescription: "The ISO description.",
@@ -90,12 +106,21 @@ The ISO resource allows you to manage Xelon custom ISOs.
 				Required:            true,
 			},
 			"tenant_id": schema.StringAttribute{
-				MarkdownDescription: "The tenant ID to whom the ISO belongs.",
+				MarkdownDescription: "The tenant ID to whom the ISO belongs. Defaults to the provider `default_tenant_id`.",
 				Optional:            true,
+				Computed:            true,
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.UseStateForUnknown(),
+					r.tenantIDPlanModifier(false),
 				},
 			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create:            true,
+				CreateDescription: "Applies to each step waiting for the resource. Defaults to 10m.",
+				Update:            true,
+				UpdateDescription: "Defaults to 10m.",
+				Delete:            true,
+				DeleteDescription: "Defaults to 10m.",
+			}),
 			"url": schema.StringAttribute{
 				MarkdownDescription: "The URL from which the ISO may be retrieved.",
 				Required:            true,
@@ -104,6 +129,10 @@ The ISO resource allows you to manage Xelon custom ISOs.
 	}
 }
 
+func (r *isoResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, response *resource.IdentitySchemaResponse) {
+	response.IdentitySchema = idIdentitySchema("The ID of the ISO.")
+}
+
 func (r *isoResource) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
 	if request.ProviderData == nil {
 		return
@@ -122,6 +151,10 @@ func (r *isoResource) Configure(_ context.Context, request resource.ConfigureReq
 }
 
 func (r *isoResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	if r.checkReadOnly("create", &response.Diagnostics) {
+		return
+	}
+
 	var data isoResourceModel
 
 	// read plan data into the model
@@ -131,6 +164,13 @@ func (r *isoResource) Create(ctx context.Context, request resource.CreateRequest
 		return
 	}
 
+	// configure timeout
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultISOTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	createRequest := &xelon.ISOCreateRequest{
 		CategoryID: int(data.CategoryID.ValueInt64()),
 		CloudID:    data.CloudID.ValueString(),
@@ -153,7 +193,7 @@ func (r *isoResource) Create(ctx context.Context, request resource.CreateRequest
 
 	isoID := iso.ID
 	tflog.Info(ctx, "Waiting for ISO to be ready", map[string]any{"iso_id": isoID})
-	err = helper.WaitISOStateReady(ctx, r.client, isoID)
+	err = helper.WaitISOStateReady(ctx, r.client, isoID, createTimeout)
 	if err != nil {
 		response.Diagnostics.AddError("Unable to wait for ISO to be ready", err.Error())
 		return
@@ -174,9 +214,14 @@ func (r *isoResource) Create(ctx context.Context, request resource.CreateRequest
 	data.Description = types.StringValue(iso.Description)
 	data.ID = types.StringValue(iso.ID)
 	data.Name = types.StringValue(iso.Name)
+	// tenant_id is not returned by the API, keep it unset if neither configured nor defaulted
+	if data.TenantID.IsUnknown() {
+		data.TenantID = types.StringNull()
+	}
 
 	diags = response.State.Set(ctx, &data)
 	response.Diagnostics.Append(diags...)
+	setResourceIdentity(ctx, response.Identity, idResourceIdentityModel{ID: data.ID}, &response.Diagnostics)
 }
 
 func (r *isoResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
@@ -212,9 +257,14 @@ func (r *isoResource) Read(ctx context.Context, request resource.ReadRequest, re
 
 	diags = response.State.Set(ctx, &data)
 	response.Diagnostics.Append(diags...)
+	setResourceIdentity(ctx, response.Identity, idResourceIdentityModel{ID: data.ID}, &response.Diagnostics)
 }
 
 func (r *isoResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	if r.checkReadOnly("update", &response.Diagnostics) {
+		return
+	}
+
 	var data isoResourceModel
 
 	// read plan data into the model
@@ -224,6 +274,15 @@ func (r *isoResource) Update(ctx context.Context, request resource.UpdateRequest
 		return
 	}
 
+	// configure timeout
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultISOTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	isoID := data.ID.ValueString()
 	updateRequest := &xelon.ISOUpdateRequest{
 		CategoryID:  int(data.CategoryID.ValueInt64()),
@@ -242,12 +301,20 @@ func (r *isoResource) Update(ctx context.Context, request resource.UpdateRequest
 	// map response body to attributes
 	data.Description = types.StringValue(iso.Description)
 	data.Name = types.StringValue(iso.Name)
+	if data.TenantID.IsUnknown() {
+		data.TenantID = types.StringNull()
+	}
 
 	diags = response.State.Set(ctx, &data)
 	response.Diagnostics.Append(diags...)
+	setResourceIdentity(ctx, response.Identity, idResourceIdentityModel{ID: data.ID}, &response.Diagnostics)
 }
 
 func (r *isoResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	if r.checkReadOnly("delete", &response.Diagnostics) {
+		return
+	}
+
 	var data isoResourceModel
 
 	// read state data into the model
@@ -257,6 +324,15 @@ func (r *isoResource) Delete(ctx context.Context, request resource.DeleteRequest
 		return
 	}
 
+	// configure timeout
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultISOTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	isoID := data.ID.ValueString()
 	tflog.Debug(ctx, "Deleting ISO", map[string]any{"iso_id": isoID})
 	resp, err := r.client.ISOs.Delete(ctx, isoID)
@@ -273,5 +349,5 @@ func (r *isoResource) Delete(ctx context.Context, request resource.DeleteRequest
 }
 
 func (r *isoResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), request, response)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), request, response)
 }
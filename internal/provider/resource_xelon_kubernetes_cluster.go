@@ -2,6 +2,9 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"net/http"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
@@ -24,9 +27,12 @@ import (
 )
 
 var (
-	_ resource.Resource               = (*kubernetesClusterResource)(nil)
-	_ resource.ResourceWithConfigure  = (*kubernetesClusterResource)(nil)
-	_ resource.ResourceWithModifyPlan = (*kubernetesClusterResource)(nil)
+	_ resource.Resource                = (*kubernetesClusterResource)(nil)
+	_ resource.ResourceWithConfigure   = (*kubernetesClusterResource)(nil)
+	_ resource.ResourceWithImportState = (*kubernetesClusterResource)(nil)
+	_ resource.ResourceWithIdentity    = (*kubernetesClusterResource)(nil)
+	_ resource.ResourceWithModifyPlan  = (*kubernetesClusterResource)(nil)
+	_ resourceWithProviderDefaults     = (*kubernetesClusterResource)(nil)
 )
 
 const (
@@ -35,6 +41,9 @@ const (
 
 // kubernetesClusterResource is the Kubernetes cluster resource implementation.
 type kubernetesClusterResource struct {
+	withProviderDefaults
+	withReadOnlyMode
+
 	client *xelon.Client
 }
 
@@ -82,8 +91,12 @@ XKS is a Kubernetes service with a fully managed control plane and high availabi
 		Version: 0,
 		Attributes: map[string]schema.Attribute{
 			"cloud_id": schema.StringAttribute{
-				MarkdownDescription: "The ID of the cloud in which the Kubernetes cluster will be provisioned.",
-				Required:            true,
+				MarkdownDescription: "The ID of the cloud in which the Kubernetes cluster will be provisioned. Defaults to the provider `default_cloud_id`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					r.cloudIDPlanModifier(true),
+				},
 			},
 			"control_plane": schema.SingleNestedAttribute{
 				MarkdownDescription: "The configuration related to the cluster control plane.",
@@ -129,8 +142,9 @@ XKS is a Kubernetes service with a fully managed control plane and high availabi
 				},
 			},
 			"kubernetes_version": schema.StringAttribute{
-				MarkdownDescription: "Desired Kubernetes version for the cluster.",
-				Required:            true,
+				MarkdownDescription: "Desired Kubernetes version for the cluster. " +
+					"Changing this value upgrades the cluster in place. Downgrades and skipping minor versions are not supported.",
+				Required: true,
 			},
 			"load_balancer": schema.SingleNestedAttribute{
 				MarkdownDescription: "The configuration related to the load balancer.",
@@ -173,12 +187,17 @@ XKS is a Kubernetes service with a fully managed control plane and high availabi
 				Required:            true,
 			},
 			"talos_version": schema.StringAttribute{
-				MarkdownDescription: "Desired Talos version for the Kubernetes cluster.",
-				Required:            true,
+				MarkdownDescription: "Desired Talos version for the Kubernetes cluster. " +
+					"Changing this value upgrades the cluster in place. Downgrades and skipping minor versions are not supported.",
+				Required: true,
 			},
 			"tenant_id": schema.StringAttribute{
-				MarkdownDescription: "The tenant ID of the Kubernetes cluster.",
-				Required:            true,
+				MarkdownDescription: "The tenant ID of the Kubernetes cluster. Defaults to the provider `default_tenant_id`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					r.tenantIDPlanModifier(true),
+				},
 			},
 			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
 				Create:            true,
@@ -190,6 +209,10 @@ XKS is a Kubernetes service with a fully managed control plane and high availabi
 	}
 }
 
+func (r *kubernetesClusterResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, response *resource.IdentitySchemaResponse) {
+	response.IdentitySchema = idIdentitySchema("The ID of the Kubernetes cluster.")
+}
+
 func (r *kubernetesClusterResource) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
 	if request.ProviderData == nil {
 		return
@@ -208,6 +231,10 @@ func (r *kubernetesClusterResource) Configure(_ context.Context, request resourc
 }
 
 func (r *kubernetesClusterResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	if r.checkReadOnly("create", &response.Diagnostics) {
+		return
+	}
+
 	var data kubernetesClusterResourceModel
 
 	// read plan data into the model
@@ -326,6 +353,7 @@ func (r *kubernetesClusterResource) Create(ctx context.Context, request resource
 	response.Diagnostics.Append(data.fromAPI(ctx, kubernetesCluster, controlPlane, loadBalancer)...)
 	diags = response.State.Set(ctx, &data)
 	response.Diagnostics.Append(diags...)
+	setResourceIdentity(ctx, response.Identity, idResourceIdentityModel{ID: data.ID}, &response.Diagnostics)
 }
 
 func (r *kubernetesClusterResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
@@ -340,8 +368,13 @@ func (r *kubernetesClusterResource) Read(ctx context.Context, request resource.R
 
 	kubernetesClusterID := data.ID.ValueString()
 	tflog.Debug(ctx, "Getting Kubernetes cluster", map[string]any{"kubernetes_cluster_id": kubernetesClusterID})
-	kubernetesCluster, _, err := r.client.Kubernetes.Get(ctx, kubernetesClusterID)
+	kubernetesCluster, resp, err := r.client.Kubernetes.Get(ctx, kubernetesClusterID)
 	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			// if the Kubernetes cluster is somehow already destroyed, mark as successfully gone
+			response.State.RemoveResource(ctx)
+			return
+		}
 		response.Diagnostics.AddError("Unable to get Kubernetes cluster", err.Error())
 		return
 	}
@@ -367,9 +400,14 @@ func (r *kubernetesClusterResource) Read(ctx context.Context, request resource.R
 	response.Diagnostics.Append(data.fromAPI(ctx, kubernetesCluster, controlPlane, loadBalancer)...)
 	diags = response.State.Set(ctx, &data)
 	response.Diagnostics.Append(diags...)
+	setResourceIdentity(ctx, response.Identity, idResourceIdentityModel{ID: data.ID}, &response.Diagnostics)
 }
 
 func (r *kubernetesClusterResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	if r.checkReadOnly("update", &response.Diagnostics) {
+		return
+	}
+
 	var plan, state kubernetesClusterResourceModel
 
 	// read plan and state data into the model
@@ -468,6 +506,46 @@ func (r *kubernetesClusterResource) Update(ctx context.Context, request resource
 		})
 	}
 
+	if !plan.KubernetesVersion.Equal(state.KubernetesVersion) || !plan.TalosVersion.Equal(state.TalosVersion) {
+		upgradeRequest := &xelon.KubernetesClusterUpgradeRequest{
+			KubernetesVersion: plan.KubernetesVersion.ValueString(),
+			TalosVersion:      plan.TalosVersion.ValueString(),
+		}
+		tflog.Debug(ctx, "Upgrading Kubernetes cluster version", map[string]any{
+			"kubernetes_cluster_id": kubernetesClusterID,
+			"payload":               upgradeRequest,
+		})
+		_, err := r.client.Kubernetes.Upgrade(ctx, kubernetesClusterID, upgradeRequest)
+		if err != nil {
+			response.Diagnostics.AddError("Unable to upgrade Kubernetes cluster version", err.Error())
+			return
+		}
+		tflog.Debug(ctx, "Upgraded Kubernetes cluster version", map[string]any{
+			"kubernetes_cluster_id": kubernetesClusterID,
+			"payload":               upgradeRequest,
+		})
+
+		tflog.Info(ctx, "Waiting for Kubernetes cluster to be ready", map[string]any{"kubernetes_cluster_id": kubernetesClusterID})
+		err = helper.WaitKubernetesClusterStatusReady(ctx, r.client, kubernetesClusterID, updateTimeout)
+		if err != nil {
+			// set id to state that the resource will be marked as tainted
+			response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("id"), kubernetesClusterID)...)
+			response.Diagnostics.AddError("Unable to wait for Kubernetes cluster to be ready", err.Error())
+			return
+		}
+		tflog.Info(ctx, "Kubernetes cluster is ready", map[string]any{"kubernetes_cluster_id": kubernetesClusterID})
+
+		tflog.Info(ctx, "Waiting for Kubernetes cluster to be healthy", map[string]any{"kubernetes_cluster_id": kubernetesClusterID})
+		err = helper.WaitKubernetesClusterControlPlaneStatusHealthy(ctx, r.client, kubernetesClusterID, updateTimeout)
+		if err != nil {
+			// set id to state that the resource will be marked as tainted
+			response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("id"), kubernetesClusterID)...)
+			response.Diagnostics.AddError("Unable to wait for Kubernetes cluster to be healthy", err.Error())
+			return
+		}
+		tflog.Info(ctx, "Kubernetes cluster is healthy", map[string]any{"kubernetes_cluster_id": kubernetesClusterID})
+	}
+
 	if !planControlPlaneModel.CPUCoreCount.Equal(stateControlPlaneModel.CPUCoreCount) ||
 		!planControlPlaneModel.DiskSize.Equal(stateControlPlaneModel.DiskSize) ||
 		!planControlPlaneModel.Memory.Equal(stateControlPlaneModel.Memory) {
@@ -529,9 +607,14 @@ func (r *kubernetesClusterResource) Update(ctx context.Context, request resource
 	response.Diagnostics.Append(plan.fromAPI(ctx, kubernetesCluster, controlPlane, loadBalancer)...)
 	diags = response.State.Set(ctx, &plan)
 	response.Diagnostics.Append(diags...)
+	setResourceIdentity(ctx, response.Identity, idResourceIdentityModel{ID: plan.ID}, &response.Diagnostics)
 }
 
 func (r *kubernetesClusterResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	if r.checkReadOnly("delete", &response.Diagnostics) {
+		return
+	}
+
 	var data kubernetesClusterResourceModel
 
 	// read state data into the model
@@ -551,6 +634,10 @@ func (r *kubernetesClusterResource) Delete(ctx context.Context, request resource
 	tflog.Debug(ctx, "Deleted Kubernetes cluster", map[string]any{"kubernetes_cluster_id": kubernetesClusterID})
 }
 
+func (r *kubernetesClusterResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), request, response)
+}
+
 func (r *kubernetesClusterResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
 	if request.Plan.Raw.IsNull() || request.State.Raw.IsNull() {
 		return
@@ -581,6 +668,24 @@ func (r *kubernetesClusterResource) ModifyPlan(ctx context.Context, request reso
 		planLoadBalancer,
 		stateLoadBalancer,
 	)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !kubernetesClusterVersionChanged(plan, state) {
+		return
+	}
+
+	cloudID := plan.CloudID.ValueString()
+	tflog.Debug(ctx, "Getting cluster version mappings", map[string]any{"cloud_id": cloudID})
+	versionMapping, _, err := r.client.Kubernetes.ListVersionMapping(ctx, cloudID)
+	if err != nil {
+		response.Diagnostics.AddError("Unable to list cluster versions", err.Error())
+		return
+	}
+	tflog.Debug(ctx, "Got cluster version mappings", map[string]any{"data": versionMapping})
+
+	response.Diagnostics.Append(validateKubernetesClusterVersionChanges(plan, state, versionMapping)...)
 }
 
 func (m *kubernetesClusterResourceModel) fromAPI(ctx context.Context, kubernetesCluster *xelon.KubernetesCluster, controlPlane *xelon.KubernetesClusterControlPlane, loadBalancer *xelon.KubernetesClusterLoadBalancer) diag.Diagnostics {
@@ -595,6 +700,17 @@ func (m *kubernetesClusterResourceModel) fromAPI(ctx context.Context, kubernetes
 	}
 	m.CloudID = types.StringValue(cloudID)
 
+	// keep the configured versions if the API does not report them (yet)
+	if kubernetesCluster.KubernetesVersion != "" {
+		m.KubernetesVersion = types.StringValue(kubernetesCluster.KubernetesVersion)
+	}
+	if kubernetesCluster.TalosVersion != "" {
+		m.TalosVersion = types.StringValue(kubernetesCluster.TalosVersion)
+	}
+	if kubernetesCluster.Tenant != nil {
+		m.TenantID = types.StringValue(kubernetesCluster.Tenant.ID)
+	}
+
 	controlPlaneModel, d := types.ObjectValueFrom(ctx, kubernetesClusterNodeSpecAttributeTypes(), kubernetesClusterNodeSpecResourceModel{
 		CPUCoreCount:            types.Int64Value(int64(controlPlane.CPUCores)),
 		DiskSize:                types.Int64Value(int64(controlPlane.DiskSize)),
@@ -702,6 +818,76 @@ func validateKubernetesClusterHAChanges(planControlPlane, stateControlPlane, pla
 	return diags
 }
 
+// kubernetesClusterVersionChanged reports whether the plan requests a known
+// Kubernetes or Talos version that differs from the one in state.
+func kubernetesClusterVersionChanged(plan, state kubernetesClusterResourceModel) bool {
+	if plan.KubernetesVersion.IsUnknown() || plan.TalosVersion.IsUnknown() {
+		return false
+	}
+
+	return !plan.KubernetesVersion.Equal(state.KubernetesVersion) || !plan.TalosVersion.Equal(state.TalosVersion)
+}
+
+func validateKubernetesClusterVersionChanges(plan, state kubernetesClusterResourceModel, versionMapping map[string][]string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	planKubernetesVersion := plan.KubernetesVersion.ValueString()
+	planTalosVersion := plan.TalosVersion.ValueString()
+
+	if err := helper.ValidateVersionUpgrade(state.TalosVersion.ValueString(), planTalosVersion); err != nil {
+		diags.AddAttributeError(
+			path.Root("talos_version"),
+			"Unsupported Talos version upgrade",
+			err.Error(),
+		)
+	}
+	if err := helper.ValidateVersionUpgrade(state.KubernetesVersion.ValueString(), planKubernetesVersion); err != nil {
+		diags.AddAttributeError(
+			path.Root("kubernetes_version"),
+			"Unsupported Kubernetes version upgrade",
+			err.Error(),
+		)
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	var kubernetesVersions []string
+	for talosVersion, k8sVersions := range versionMapping {
+		if helper.ContainsVersion([]string{talosVersion}, planTalosVersion) {
+			kubernetesVersions = append([]string(nil), k8sVersions...)
+			break
+		}
+	}
+	if kubernetesVersions == nil {
+		talosVersions := make([]string, 0, len(versionMapping))
+		for talosVersion := range versionMapping {
+			talosVersions = append(talosVersions, talosVersion)
+		}
+		helper.SortVersions(talosVersions, func(s string) string { return s })
+
+		diags.AddAttributeError(
+			path.Root("talos_version"),
+			"Unsupported Talos version",
+			fmt.Sprintf("Talos version %s is not available. Available Talos versions: %s.", planTalosVersion, strings.Join(talosVersions, ", ")),
+		)
+		return diags
+	}
+
+	if !helper.ContainsVersion(kubernetesVersions, planKubernetesVersion) {
+		helper.SortVersions(kubernetesVersions, func(s string) string { return s })
+
+		diags.AddAttributeError(
+			path.Root("kubernetes_version"),
+			"Unsupported Kubernetes version",
+			fmt.Sprintf("Kubernetes version %s is not available for Talos version %s. Available Kubernetes versions: %s.",
+				planKubernetesVersion, planTalosVersion, strings.Join(kubernetesVersions, ", ")),
+		)
+	}
+
+	return diags
+}
+
 func kubernetesClusterHAChange(planValue, stateValue types.Bool) kubernetesClusterHATransition {
 	if planValue.IsNull() || planValue.IsUnknown() || stateValue.IsNull() || stateValue.IsUnknown() {
 		return kubernetesClusterHAUnknown
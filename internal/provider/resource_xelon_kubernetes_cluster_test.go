@@ -2,19 +2,28 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
-	"github.com/hashicorp/terraform-plugin-framework/resource"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/Xelon-AG/xelon-sdk-go/xelon"
 )
 
 func TestResourceXelonKubernetesCluster_ModifyPlan_CreateControlPlaneHADisabled(t *testing.T) {
@@ -151,6 +160,181 @@ func TestResourceXelonKubernetesCluster_ModifyPlan_LoadBalancerHAUnknownPlanAllo
 	assert.False(t, response.Diagnostics.HasError())
 }
 
+func TestResourceXelonKubernetesCluster_ValidateVersionChanges(t *testing.T) {
+	versionMapping := map[string][]string{
+		"1.9.0":  {"1.31.0", "1.30.4"},
+		"1.10.0": {"1.32.0", "1.31.4"},
+	}
+
+	testCases := map[string]struct {
+		kubernetesVersion string
+		talosVersion      string
+		expected          diag.Diagnostics
+	}{
+		"kubernetes patch upgrade": {
+			kubernetesVersion: "1.31.4",
+			talosVersion:      "1.10.0",
+		},
+		"kubernetes minor upgrade": {
+			kubernetesVersion: "1.32.0",
+			talosVersion:      "1.10.0",
+		},
+		"kubernetes downgrade": {
+			kubernetesVersion: "1.30.4",
+			talosVersion:      "1.9.0",
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("kubernetes_version"),
+					"Unsupported Kubernetes version upgrade",
+					"downgrading from 1.31.0 to 1.30.4 is not supported",
+				),
+			},
+		},
+		"talos downgrade": {
+			kubernetesVersion: "1.31.0",
+			talosVersion:      "1.8.0",
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("talos_version"),
+					"Unsupported Talos version upgrade",
+					"downgrading from 1.9.0 to 1.8.0 is not supported",
+				),
+			},
+		},
+		"kubernetes skipped minor": {
+			kubernetesVersion: "1.33.0",
+			talosVersion:      "1.10.0",
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("kubernetes_version"),
+					"Unsupported Kubernetes version upgrade",
+					"upgrading from 1.31.0 to 1.33.0 skips at least one minor version, upgrade to 1.32 first",
+				),
+			},
+		},
+		"unknown talos version": {
+			kubernetesVersion: "1.31.0",
+			talosVersion:      "1.9.5",
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("talos_version"),
+					"Unsupported Talos version",
+					"Talos version 1.9.5 is not available. Available Talos versions: 1.10.0, 1.9.0.",
+				),
+			},
+		},
+		"kubernetes version not available for talos version": {
+			kubernetesVersion: "1.32.0",
+			talosVersion:      "1.9.0",
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("kubernetes_version"),
+					"Unsupported Kubernetes version",
+					"Kubernetes version 1.32.0 is not available for Talos version 1.9.0. Available Kubernetes versions: 1.31.0, 1.30.4.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			plan := kubernetesClusterResourceModel{
+				KubernetesVersion: types.StringValue(testCase.kubernetesVersion),
+				TalosVersion:      types.StringValue(testCase.talosVersion),
+			}
+			state := kubernetesClusterResourceModel{
+				KubernetesVersion: types.StringValue("1.31.0"),
+				TalosVersion:      types.StringValue("1.9.0"),
+			}
+
+			diags := validateKubernetesClusterVersionChanges(plan, state, versionMapping)
+
+			assert.True(t, diags.Equal(testCase.expected), "unexpected diagnostics: %v", diags)
+		})
+	}
+}
+
+func TestResourceXelonKubernetesCluster_VersionChanged(t *testing.T) {
+	state := kubernetesClusterResourceModel{
+		KubernetesVersion: types.StringValue("1.31.0"),
+		TalosVersion:      types.StringValue("1.9.0"),
+	}
+
+	assert.False(t, kubernetesClusterVersionChanged(state, state))
+	assert.True(t, kubernetesClusterVersionChanged(kubernetesClusterResourceModel{
+		KubernetesVersion: types.StringValue("1.31.4"),
+		TalosVersion:      types.StringValue("1.9.0"),
+	}, state))
+	assert.False(t, kubernetesClusterVersionChanged(kubernetesClusterResourceModel{
+		KubernetesVersion: types.StringUnknown(),
+		TalosVersion:      types.StringValue("1.10.0"),
+	}, state))
+}
+
+func TestResourceXelonKubernetesCluster_Model_FromAPI(t *testing.T) {
+	kubernetesCluster := &xelon.KubernetesCluster{
+		Cloud:             &xelon.Cloud{ID: "cloud-123"},
+		ID:                "kubernetes-cluster-id",
+		KubernetesVersion: "v1.32.0",
+		Name:              "kubernetes-cluster-name",
+		TalosVersion:      "v1.11.1",
+		Tenant:            &xelon.Tenant{ID: "tenant-123"},
+	}
+	controlPlane := &xelon.KubernetesClusterControlPlane{
+		CPUCores: 4,
+		DiskSize: 60,
+		Nodes:    []xelon.KubernetesClusterNode{{ID: "1111"}, {ID: "2222"}, {ID: "3333"}},
+		RAM:      8,
+	}
+	loadBalancer := &xelon.KubernetesClusterLoadBalancer{
+		CPUCores:  2,
+		DiskSize:  50,
+		Instances: []xelon.KubernetesClusterNode{{ID: "4444"}},
+		RAM:       4,
+	}
+
+	var model kubernetesClusterResourceModel
+	diags := model.fromAPI(context.Background(), kubernetesCluster, controlPlane, loadBalancer)
+
+	require.False(t, diags.HasError())
+	assert.Equal(t, types.StringValue("cloud-123"), model.CloudID)
+	assert.Equal(t, types.StringValue("kubernetes-cluster-id"), model.ID)
+	assert.Equal(t, types.StringValue("v1.32.0"), model.KubernetesVersion)
+	assert.Equal(t, types.StringValue("kubernetes-cluster-name"), model.Name)
+	assert.Equal(t, types.StringValue("v1.11.1"), model.TalosVersion)
+	assert.Equal(t, types.StringValue("tenant-123"), model.TenantID)
+	assert.Equal(t, types.ObjectValueMust(kubernetesClusterNodeSpecAttributeTypes(), map[string]attr.Value{
+		"cpu_core_count":            types.Int64Value(4),
+		"disk_size":                 types.Int64Value(60),
+		"high_availability_enabled": types.BoolValue(true),
+		"memory":                    types.Int64Value(8),
+	}), model.ControlPlane)
+	assert.Equal(t, types.ObjectValueMust(kubernetesClusterNodeSpecAttributeTypes(), map[string]attr.Value{
+		"cpu_core_count":            types.Int64Value(2),
+		"disk_size":                 types.Int64Value(50),
+		"high_availability_enabled": types.BoolValue(false),
+		"memory":                    types.Int64Value(4),
+	}), model.LoadBalancer)
+}
+
+func TestResourceXelonKubernetesCluster_Model_FromAPI_WithoutVersions(t *testing.T) {
+	model := kubernetesClusterResourceModel{
+		KubernetesVersion: types.StringValue("v1.31.4"),
+		TalosVersion:      types.StringValue("v1.10.3"),
+	}
+
+	diags := model.fromAPI(
+		context.Background(),
+		&xelon.KubernetesCluster{ID: "kubernetes-cluster-id"},
+		&xelon.KubernetesClusterControlPlane{},
+		&xelon.KubernetesClusterLoadBalancer{},
+	)
+
+	require.False(t, diags.HasError())
+	assert.Equal(t, types.StringValue("v1.31.4"), model.KubernetesVersion)
+	assert.Equal(t, types.StringValue("v1.10.3"), model.TalosVersion)
+}
+
 type kubernetesClusterModifyPlanCase struct {
 	planControlPlaneHA  types.Bool
 	planLoadBalancerHA  types.Bool
@@ -165,7 +349,7 @@ type kubernetesClusterPlanValues struct {
 	id             types.String
 }
 
-func testKubernetesClusterModifyPlanResponse(t *testing.T, testCase kubernetesClusterModifyPlanCase) *resource.ModifyPlanResponse {
+func testKubernetesClusterModifyPlanResponse(t *testing.T, testCase kubernetesClusterModifyPlanCase) *fwresource.ModifyPlanResponse {
 	t.Helper()
 
 	ctx := context.Background()
@@ -190,8 +374,8 @@ func testKubernetesClusterModifyPlanResponse(t *testing.T, testCase kubernetesCl
 		state.Raw = statePlan.Raw
 	}
 
-	response := &resource.ModifyPlanResponse{}
-	NewKubernetesClusterResource().(*kubernetesClusterResource).ModifyPlan(ctx, resource.ModifyPlanRequest{
+	response := &fwresource.ModifyPlanResponse{}
+	NewKubernetesClusterResource().(*kubernetesClusterResource).ModifyPlan(ctx, fwresource.ModifyPlanRequest{
 		Plan:  plan,
 		State: state,
 	}, response)
@@ -203,8 +387,8 @@ func testKubernetesClusterResourceSchema(t *testing.T) schema.Schema {
 	t.Helper()
 
 	r := NewKubernetesClusterResource()
-	response := &resource.SchemaResponse{}
-	r.Schema(context.Background(), resource.SchemaRequest{}, response)
+	response := &fwresource.SchemaResponse{}
+	r.Schema(context.Background(), fwresource.SchemaRequest{}, response)
 	require.False(t, response.Diagnostics.HasError())
 
 	return response.Schema
@@ -281,3 +465,100 @@ func expectedKubernetesClusterLoadBalancerHAPartialUpgradeDiagnostics() diag.Dia
 		),
 	}
 }
+
+func TestResourceXelonKubernetesCluster_Import(t *testing.T) {
+	server := newFakeXelonServer(t)
+	server.Mutate(func(s *fakeXelonServer) {
+		s.kubernetesClusters["kc-123"] = &xelon.KubernetesCluster{
+			Cloud:             &xelon.Cloud{ID: "cloud-123"},
+			Health:            &xelon.KubernetesClusterHealth{Status: "healthy"},
+			ID:                "kc-123",
+			KubernetesVersion: "v1.32.0",
+			Name:              "imported",
+			Status:            "ready",
+			TalosVersion:      "v1.11.1",
+			Tenant:            &xelon.Tenant{ID: "tenant-123"},
+		}
+		s.kubernetesControlPlanes["kc-123"] = &xelon.KubernetesClusterControlPlane{
+			CPUCores: 2,
+			DiskSize: 50,
+			Nodes:    []xelon.KubernetesClusterNode{{ID: "1"}, {ID: "2"}, {ID: "3"}},
+			RAM:      4,
+		}
+		s.kubernetesLoadBalancers["kc-123"] = &xelon.KubernetesClusterLoadBalancer{
+			CPUCores:  2,
+			DiskSize:  50,
+			Instances: []xelon.KubernetesClusterNode{{ID: "4"}, {ID: "5"}},
+			RAM:       4,
+		}
+	})
+	config := server.ProviderConfig() + testResourceXelonKubernetesClusterConfig("imported")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:             config,
+				ResourceName:       "xelon_kubernetes_cluster.test",
+				ImportState:        true,
+				ImportStateId:      "kc-123",
+				ImportStatePersist: true,
+			},
+			// imported state must match the configuration without changes
+			{
+				Config: config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"xelon_kubernetes_cluster.test",
+						tfjsonpath.New("kubernetes_version"),
+						knownvalue.StringExact("v1.32.0"),
+					),
+					statecheck.ExpectKnownValue(
+						"xelon_kubernetes_cluster.test",
+						tfjsonpath.New("talos_version"),
+						knownvalue.StringExact("v1.11.1"),
+					),
+					statecheck.ExpectKnownValue(
+						"xelon_kubernetes_cluster.test",
+						tfjsonpath.New("tenant_id"),
+						knownvalue.StringExact("tenant-123"),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestResourceXelonKubernetesCluster_ImportMissingCluster(t *testing.T) {
+	server := newFakeXelonServer(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:        server.ProviderConfig() + testResourceXelonKubernetesClusterConfig("imported"),
+				ResourceName:  "xelon_kubernetes_cluster.test",
+				ImportState:   true,
+				ImportStateId: "kc-999",
+				ExpectError:   regexp.MustCompile(`Cannot import non-existent remote object`),
+			},
+		},
+	})
+}
+
+func testResourceXelonKubernetesClusterConfig(name string) string {
+	return fmt.Sprintf(`
+resource "xelon_kubernetes_cluster" "test" {
+  cloud_id           = "cloud-123"
+  kubernetes_version = "v1.32.0"
+  name               = %[1]q
+  talos_version      = "v1.11.1"
+  tenant_id          = "tenant-123"
+}
+`, name)
+}
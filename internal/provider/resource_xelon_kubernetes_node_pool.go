@@ -2,15 +2,18 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"slices"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -24,8 +27,10 @@ import (
 )
 
 var (
-	_ resource.Resource              = (*kubernetesNodePoolResource)(nil)
-	_ resource.ResourceWithConfigure = (*kubernetesNodePoolResource)(nil)
+	_ resource.Resource                = (*kubernetesNodePoolResource)(nil)
+	_ resource.ResourceWithConfigure   = (*kubernetesNodePoolResource)(nil)
+	_ resource.ResourceWithImportState = (*kubernetesNodePoolResource)(nil)
+	_ resource.ResourceWithIdentity    = (*kubernetesNodePoolResource)(nil)
 )
 
 const (
@@ -34,6 +39,8 @@ const (
 
 // kubernetesNodePoolResource is the Kubernetes node pool resource implementation.
 type kubernetesNodePoolResource struct {
+	withReadOnlyMode
+
 	client *xelon.Client
 }
 
@@ -49,6 +56,12 @@ type kubernetesNodePoolResourceModel struct {
 	Timeouts            timeouts.Value `tfsdk:"timeouts"`
 }
 
+// kubernetesNodePoolResourceIdentityModel maps the Kubernetes node pool resource identity schema data.
+type kubernetesNodePoolResourceIdentityModel struct {
+	ID                  types.String `tfsdk:"id"`
+	KubernetesClusterID types.String `tfsdk:"kubernetes_cluster_id"`
+}
+
 func NewKubernetesNodePoolResource() resource.Resource {
 	return &kubernetesNodePoolResource{}
 }
@@ -118,6 +131,21 @@ XKS is a Kubernetes service with a fully managed control plane and high availabi
 	}
 }
 
+func (r *kubernetesNodePoolResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, response *resource.IdentitySchemaResponse) {
+	response.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "The ID of the node pool.",
+				RequiredForImport: true,
+			},
+			"kubernetes_cluster_id": identityschema.StringAttribute{
+				Description:       "The ID of the Kubernetes cluster to which the node pool is associated.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *kubernetesNodePoolResource) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
 	if request.ProviderData == nil {
 		return
@@ -136,6 +164,10 @@ func (r *kubernetesNodePoolResource) Configure(_ context.Context, request resour
 }
 
 func (r *kubernetesNodePoolResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	if r.checkReadOnly("create", &response.Diagnostics) {
+		return
+	}
+
 	var data kubernetesNodePoolResourceModel
 
 	// read plan data into the model
@@ -205,6 +237,7 @@ func (r *kubernetesNodePoolResource) Create(ctx context.Context, request resourc
 	data.fromAPI(nodePool, kubernetesClusterID)
 	diags = response.State.Set(ctx, &data)
 	response.Diagnostics.Append(diags...)
+	setResourceIdentity(ctx, response.Identity, kubernetesNodePoolResourceIdentityModel{ID: data.ID, KubernetesClusterID: data.KubernetesClusterID}, &response.Diagnostics)
 }
 
 func (r *kubernetesNodePoolResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
@@ -239,9 +272,14 @@ func (r *kubernetesNodePoolResource) Read(ctx context.Context, request resource.
 	data.fromAPI(nodePool, kubernetesClusterID)
 	diags = response.State.Set(ctx, &data)
 	response.Diagnostics.Append(diags...)
+	setResourceIdentity(ctx, response.Identity, kubernetesNodePoolResourceIdentityModel{ID: data.ID, KubernetesClusterID: data.KubernetesClusterID}, &response.Diagnostics)
 }
 
 func (r *kubernetesNodePoolResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	if r.checkReadOnly("update", &response.Diagnostics) {
+		return
+	}
+
 	var plan, state kubernetesNodePoolResourceModel
 
 	// read plan and state data into the model
@@ -387,9 +425,14 @@ func (r *kubernetesNodePoolResource) Update(ctx context.Context, request resourc
 	plan.fromAPI(nodePool, kubernetesClusterID)
 	diags = response.State.Set(ctx, &plan)
 	response.Diagnostics.Append(diags...)
+	setResourceIdentity(ctx, response.Identity, kubernetesNodePoolResourceIdentityModel{ID: plan.ID, KubernetesClusterID: plan.KubernetesClusterID}, &response.Diagnostics)
 }
 
 func (r *kubernetesNodePoolResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	if r.checkReadOnly("delete", &response.Diagnostics) {
+		return
+	}
+
 	var data kubernetesNodePoolResourceModel
 
 	// read state data into the model
@@ -416,6 +459,27 @@ func (r *kubernetesNodePoolResource) Delete(ctx context.Context, request resourc
 	})
 }
 
+func (r *kubernetesNodePoolResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	var identity kubernetesNodePoolResourceIdentityModel
+	if request.ID != "" {
+		kubernetesClusterID, nodePoolID, err := parseKubernetesNodePoolImportID(request.ID)
+		if err != nil {
+			response.Diagnostics.AddError("Invalid import identifier", err.Error())
+			return
+		}
+		identity = kubernetesNodePoolResourceIdentityModel{ID: types.StringValue(nodePoolID), KubernetesClusterID: types.StringValue(kubernetesClusterID)}
+	} else {
+		response.Diagnostics.Append(request.Identity.Get(ctx, &identity)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("kubernetes_cluster_id"), identity.KubernetesClusterID)...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("id"), identity.ID)...)
+	setResourceIdentity(ctx, response.Identity, identity, &response.Diagnostics)
+}
+
 func (m *kubernetesNodePoolResourceModel) fromAPI(nodePool *xelon.KubernetesClusterNodePool, kubernetesClusterID string) {
 	m.CPUCoreCount = types.Int64Value(int64(nodePool.CPUCores))
 	m.DiskSize = types.Int64Value(int64(nodePool.DiskSize))
@@ -425,3 +489,12 @@ func (m *kubernetesNodePoolResourceModel) fromAPI(nodePool *xelon.KubernetesClus
 	m.Name = types.StringValue(nodePool.Name)
 	m.NodeCount = types.Int64Value(int64(len(nodePool.Nodes)))
 }
+
+func parseKubernetesNodePoolImportID(importID string) (string, string, error) {
+	kubernetesClusterID, nodePoolID, ok := strings.Cut(importID, "/")
+	if !ok || kubernetesClusterID == "" || nodePoolID == "" || strings.Contains(nodePoolID, "/") {
+		return "", "", errors.New("expected format: <kubernetes_cluster_id>/<node_pool_id>")
+	}
+
+	return kubernetesClusterID, nodePoolID, nil
+}
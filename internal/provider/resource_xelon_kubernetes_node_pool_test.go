@@ -1,10 +1,18 @@
 package provider
 
 import (
+	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/Xelon-AG/xelon-sdk-go/xelon"
 )
@@ -60,3 +68,105 @@ func TestKubernetesNodePoolResourceModel_fromAPI_withNodes(t *testing.T) {
 
 	assert.Equal(t, expectedModel, actualModel)
 }
+
+func TestKubernetesNodePoolResource_parseImportID(t *testing.T) {
+	kubernetesClusterID, nodePoolID, err := parseKubernetesNodePoolImportID("kubernetes-cluster-id/node-pool-id")
+
+	require.NoError(t, err)
+	assert.Equal(t, "kubernetes-cluster-id", kubernetesClusterID)
+	assert.Equal(t, "node-pool-id", nodePoolID)
+}
+
+func TestKubernetesNodePoolResource_parseImportID_invalid(t *testing.T) {
+	testCases := []string{
+		"",
+		"node-pool-id",
+		"/node-pool-id",
+		"kubernetes-cluster-id/",
+		"kubernetes-cluster-id/node-pool-id/extra",
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase, func(t *testing.T) {
+			_, _, err := parseKubernetesNodePoolImportID(testCase)
+			require.EqualError(t, err, "expected format: <kubernetes_cluster_id>/<node_pool_id>")
+		})
+	}
+}
+
+func TestResourceXelonKubernetesNodePool_Import(t *testing.T) {
+	server := newFakeXelonServer(t)
+	server.Mutate(func(s *fakeXelonServer) {
+		s.kubernetesClusters["kc-123"] = &xelon.KubernetesCluster{ID: "kc-123", Name: "cluster"}
+		s.kubernetesNodePools["kc-123"] = []xelon.KubernetesClusterNodePool{{
+			CPUCores: 2,
+			DiskSize: 50,
+			ID:       "np-456",
+			Name:     "imported",
+			Nodes: []xelon.KubernetesClusterNode{
+				{ID: "1", Status: "Deployed"},
+				{ID: "2", Status: "Deployed"},
+				{ID: "3", Status: "Deployed"},
+			},
+			RAM: 4,
+		}}
+	})
+	config := server.ProviderConfig() + testResourceXelonKubernetesNodePoolConfig("kc-123", "imported")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:        config,
+				ResourceName:  "xelon_kubernetes_node_pool.test",
+				ImportState:   true,
+				ImportStateId: "np-456",
+				ExpectError:   regexp.MustCompile(`expected format: <kubernetes_cluster_id>/<node_pool_id>`),
+			},
+			{
+				Config:        config,
+				ResourceName:  "xelon_kubernetes_node_pool.test",
+				ImportState:   true,
+				ImportStateId: "kc-123/np-999",
+				ExpectError:   regexp.MustCompile(`Cannot import non-existent remote object`),
+			},
+			{
+				Config:             config,
+				ResourceName:       "xelon_kubernetes_node_pool.test",
+				ImportState:        true,
+				ImportStateId:      "kc-123/np-456",
+				ImportStatePersist: true,
+			},
+			// imported state must match the configuration without changes
+			{
+				Config: config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"xelon_kubernetes_node_pool.test",
+						tfjsonpath.New("kubernetes_cluster_id"),
+						knownvalue.StringExact("kc-123"),
+					),
+					statecheck.ExpectKnownValue(
+						"xelon_kubernetes_node_pool.test",
+						tfjsonpath.New("node_count"),
+						knownvalue.Int64Exact(3),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testResourceXelonKubernetesNodePoolConfig(kubernetesClusterID, name string) string {
+	return fmt.Sprintf(`
+resource "xelon_kubernetes_node_pool" "test" {
+  kubernetes_cluster_id = %[1]q
+  name                  = %[2]q
+}
+`, kubernetesClusterID, name)
+}
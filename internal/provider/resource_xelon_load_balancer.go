@@ -4,7 +4,9 @@ import (
 	"context"
 	"net/http"
 	"slices"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -25,26 +27,36 @@ var (
 	_ resource.Resource                = (*loadBalancerResource)(nil)
 	_ resource.ResourceWithConfigure   = (*loadBalancerResource)(nil)
 	_ resource.ResourceWithImportState = (*loadBalancerResource)(nil)
+	_ resource.ResourceWithIdentity    = (*loadBalancerResource)(nil)
+	_ resourceWithProviderDefaults     = (*loadBalancerResource)(nil)
+)
+
+const (
+	defaultLoadBalancerTimeout = 10 * time.Minute
 )
 
 // loadBalancerResource is the load balancer resource implementation.
 type loadBalancerResource struct {
+	withProviderDefaults
+	withReadOnlyMode
+
 	client *xelon.Client
 }
 
 // loadBalancerResourceModel maps the load balancer resource schema data.
 type loadBalancerResourceModel struct {
-	CloudID             types.String `tfsdk:"cloud_id"`
-	DeviceIDs           types.Set    `tfsdk:"device_ids"` // []types.String
-	ExternalIPAddress   types.String `tfsdk:"external_ipv4_address"`
-	ExternalIPAddressID types.String `tfsdk:"external_ipv4_address_id"`
-	ExternalNetworkID   types.String `tfsdk:"external_network_id"`
-	ID                  types.String `tfsdk:"id"`
-	InternalIPAddress   types.String `tfsdk:"internal_ipv4_address"`
-	Name                types.String `tfsdk:"name"`
-	NetworkID           types.String `tfsdk:"network_id"`
-	TenantID            types.String `tfsdk:"tenant_id"`
-	Type                types.String `tfsdk:"type"`
+	CloudID             types.String   `tfsdk:"cloud_id"`
+	DeviceIDs           types.Set      `tfsdk:"device_ids"` // []types.String
+	ExternalIPAddress   types.String   `tfsdk:"external_ipv4_address"`
+	ExternalIPAddressID types.String   `tfsdk:"external_ipv4_address_id"`
+	ExternalNetworkID   types.String   `tfsdk:"external_network_id"`
+	ID                  types.String   `tfsdk:"id"`
+	InternalIPAddress   types.String   `tfsdk:"internal_ipv4_address"`
+	Name                types.String   `tfsdk:"name"`
+	NetworkID           types.String   `tfsdk:"network_id"`
+	TenantID            types.String   `tfsdk:"tenant_id"`
+	Timeouts            timeouts.Value `tfsdk:"timeouts"`
+	Type                types.String   `tfsdk:"type"`
 }
 
 func NewLoadBalancerResource() resource.Resource {
@@ -55,7 +67,7 @@ func (r *loadBalancerResource) Metadata(_ context.Context, _ resource.MetadataRe
 	response.TypeName = "xelon_load_balancer"
 }
 
-func (r *loadBalancerResource) Schema(_ context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+func (r *loadBalancerResource) Schema(ctx context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
 	response.Schema = schema.Schema{
 		MarkdownDescription: `
 The load balancer resource allows you to manage Xelon load balancers.
@@ -65,8 +77,12 @@ Load balancers sit in front of your application and distribute incoming traffic
 		Version: 0,
 		Attributes: map[string]schema.Attribute{
 			"cloud_id": schema.StringAttribute{
-				MarkdownDescription: "The ID of the cloud associated with the load balancer.",
-				Required:            true,
+				MarkdownDescription: "The ID of the cloud associated with the load balancer. Defaults to the provider `default_cloud_id`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					r.cloudIDPlanModifier(true),
+				},
 			},
 			"device_ids": schema.SetAttribute{
 				MarkdownDescription: "The list of device IDs to associate with the load balancer.",
@@ -127,9 +143,21 @@ Load balancers sit in front of your application and distribute incoming traffic
 				Required:            true,
 			},
 			"tenant_id": schema.StringAttribute{
-				MarkdownDescription: "The tenant ID to whom the load balancer belongs.",
-				Required:            true,
+				MarkdownDescription: "The tenant ID to whom the load balancer belongs. Defaults to the provider `default_tenant_id`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					r.tenantIDPlanModifier(true),
+				},
 			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create:            true,
+				CreateDescription: "Applies to each step waiting for the resource. Defaults to 10m.",
+				Update:            true,
+				UpdateDescription: "Defaults to 10m.",
+				Delete:            true,
+				DeleteDescription: "Defaults to 10m.",
+			}),
 			"type": schema.StringAttribute{
 				MarkdownDescription: "The load balancing type. Must be one of `layer4` or `layer7`.",
 				Required:            true,
@@ -141,6 +169,10 @@ Load balancers sit in front of your application and distribute incoming traffic
 	}
 }
 
+func (r *loadBalancerResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, response *resource.IdentitySchemaResponse) {
+	response.IdentitySchema = idIdentitySchema("The ID of the load balancer.")
+}
+
 func (r *loadBalancerResource) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
 	if request.ProviderData == nil {
 		return
@@ -159,6 +191,10 @@ func (r *loadBalancerResource) Configure(_ context.Context, request resource.Con
 }
 
 func (r *loadBalancerResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	if r.checkReadOnly("create", &response.Diagnostics) {
+		return
+	}
+
 	var data loadBalancerResourceModel
 
 	// read plan data into the model
@@ -168,6 +204,13 @@ func (r *loadBalancerResource) Create(ctx context.Context, request resource.Crea
 		return
 	}
 
+	// configure timeout
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultLoadBalancerTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	createRequest := &xelon.LoadBalancerCreateRequest{
 		CloudID:           data.CloudID.ValueString(),
 		InternalNetworkID: data.NetworkID.ValueString(),
@@ -208,7 +251,7 @@ func (r *loadBalancerResource) Create(ctx context.Context, request resource.Crea
 	loadBalancerID := createdLoadBalancer.ID
 
 	tflog.Info(ctx, "Waiting for load balancer to be ready", map[string]any{"load_balancer_id": loadBalancerID})
-	err = helper.WaitLoadBalancerStateReady(ctx, r.client, loadBalancerID)
+	err = helper.WaitLoadBalancerStateReady(ctx, r.client, loadBalancerID, createTimeout)
 	if err != nil {
 		response.Diagnostics.AddError("Unable to wait for load balancer to be ready", err.Error())
 		return
@@ -239,6 +282,7 @@ func (r *loadBalancerResource) Create(ctx context.Context, request resource.Crea
 
 	diags = response.State.Set(ctx, &data)
 	response.Diagnostics.Append(diags...)
+	setResourceIdentity(ctx, response.Identity, idResourceIdentityModel{ID: data.ID}, &response.Diagnostics)
 }
 
 func (r *loadBalancerResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
@@ -281,9 +325,14 @@ func (r *loadBalancerResource) Read(ctx context.Context, request resource.ReadRe
 
 	diags = response.State.Set(ctx, &data)
 	response.Diagnostics.Append(diags...)
+	setResourceIdentity(ctx, response.Identity, idResourceIdentityModel{ID: data.ID}, &response.Diagnostics)
 }
 
 func (r *loadBalancerResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	if r.checkReadOnly("update", &response.Diagnostics) {
+		return
+	}
+
 	var plan, state loadBalancerResourceModel
 
 	// read plan and state data into the model
@@ -293,6 +342,15 @@ func (r *loadBalancerResource) Update(ctx context.Context, request resource.Upda
 		return
 	}
 
+	// configure timeout
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultLoadBalancerTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	loadBalancerID := state.ID.ValueString()
 
 	if !plan.Name.Equal(state.Name) {
@@ -378,11 +436,16 @@ func (r *loadBalancerResource) Update(ctx context.Context, request resource.Upda
 		}
 	}
 
-	diags := response.State.Set(ctx, &plan)
+	diags = response.State.Set(ctx, &plan)
 	response.Diagnostics.Append(diags...)
+	setResourceIdentity(ctx, response.Identity, idResourceIdentityModel{ID: plan.ID}, &response.Diagnostics)
 }
 
 func (r *loadBalancerResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	if r.checkReadOnly("delete", &response.Diagnostics) {
+		return
+	}
+
 	var data loadBalancerResourceModel
 
 	// read state data into the model
@@ -392,6 +455,15 @@ func (r *loadBalancerResource) Delete(ctx context.Context, request resource.Dele
 		return
 	}
 
+	// configure timeout
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultLoadBalancerTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	loadBalancerID := data.ID.ValueString()
 	tflog.Debug(ctx, "Deleting load balancer", map[string]any{"load_balancer_id": loadBalancerID})
 	_, err := r.client.LoadBalancers.Delete(ctx, loadBalancerID)
@@ -403,5 +475,5 @@ func (r *loadBalancerResource) Delete(ctx context.Context, request resource.Dele
 }
 
 func (r *loadBalancerResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), request, response)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), request, response)
 }
@@ -2,12 +2,15 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -15,16 +18,21 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
+	"github.com/Xelon-AG/terraform-provider-xelon/internal/provider/helper"
 	"github.com/Xelon-AG/xelon-sdk-go/xelon"
 )
 
 var (
-	_ resource.Resource              = (*loadBalancerForwardingRuleResource)(nil)
-	_ resource.ResourceWithConfigure = (*loadBalancerForwardingRuleResource)(nil)
+	_ resource.Resource                = (*loadBalancerForwardingRuleResource)(nil)
+	_ resource.ResourceWithConfigure   = (*loadBalancerForwardingRuleResource)(nil)
+	_ resource.ResourceWithImportState = (*loadBalancerForwardingRuleResource)(nil)
+	_ resource.ResourceWithIdentity    = (*loadBalancerForwardingRuleResource)(nil)
 )
 
 // loadBalancerForwardingRuleResource is the load balancer forwarding rule resource implementation.
 type loadBalancerForwardingRuleResource struct {
+	withReadOnlyMode
+
 	client *xelon.Client
 }
 
@@ -37,6 +45,12 @@ type loadBalancerForwardingRuleResourceModel struct {
 	ToPort         types.Int64  `tfsdk:"to_port"`
 }
 
+// loadBalancerForwardingRuleResourceIdentityModel maps the load balancer forwarding rule resource identity schema data.
+type loadBalancerForwardingRuleResourceIdentityModel struct {
+	ID             types.String `tfsdk:"id"`
+	LoadBalancerID types.String `tfsdk:"load_balancer_id"`
+}
+
 func NewLoadBalancerForwardingRuleResource() resource.Resource {
 	return &loadBalancerForwardingRuleResource{}
 }
@@ -95,6 +109,21 @@ A forwarding rules specifies how to route network traffic to the Devices.
 	}
 }
 
+func (r *loadBalancerForwardingRuleResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, response *resource.IdentitySchemaResponse) {
+	response.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "The ID of the forwarding rule.",
+				RequiredForImport: true,
+			},
+			"load_balancer_id": identityschema.StringAttribute{
+				Description:       "The ID of the load balancer.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *loadBalancerForwardingRuleResource) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
 	if request.ProviderData == nil {
 		return
@@ -113,6 +142,10 @@ func (r *loadBalancerForwardingRuleResource) Configure(_ context.Context, reques
 }
 
 func (r *loadBalancerForwardingRuleResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	if r.checkReadOnly("create", &response.Diagnostics) {
+		return
+	}
+
 	var data loadBalancerForwardingRuleResourceModel
 
 	// read plan data into the model
@@ -150,24 +183,12 @@ func (r *loadBalancerForwardingRuleResource) Create(ctx context.Context, request
 	}
 	tflog.Debug(ctx, "Created forwarding rule", map[string]any{"data": forwardingRule})
 
-	// map response body to attributes
-	if len(forwardingRule.Ports) == 2 {
-		data.FromPort = types.Int64Value(int64(forwardingRule.Ports[0]))
-		data.ToPort = types.Int64Value(int64(forwardingRule.Ports[1]))
-	} else {
-		tflog.Warn(ctx, "Fallback to defined ports in configuration, because port count from backend API is not correct",
-			map[string]any{"ports": forwardingRule.Ports},
-		)
-	}
-	ipAddresses = make([]string, 0, len(forwardingRule.IPAddresses))
-	ipAddresses = append(ipAddresses, forwardingRule.IPAddresses...)
-	data.ID = types.StringValue(forwardingRule.ID)
-	data.IPAddresses, diags = types.SetValueFrom(ctx, types.StringType, ipAddresses)
+	diags = data.fromAPI(ctx, loadBalancerID, forwardingRule)
 	response.Diagnostics.Append(diags...)
-	data.LoadBalancerID = types.StringValue(loadBalancerID)
 
 	diags = response.State.Set(ctx, &data)
 	response.Diagnostics.Append(diags...)
+	setResourceIdentity(ctx, response.Identity, loadBalancerForwardingRuleResourceIdentityModel{ID: data.ID, LoadBalancerID: data.LoadBalancerID}, &response.Diagnostics)
 }
 
 func (r *loadBalancerForwardingRuleResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
@@ -182,7 +203,7 @@ func (r *loadBalancerForwardingRuleResource) Read(ctx context.Context, request r
 
 	loadBalancerID := data.LoadBalancerID.ValueString()
 	tflog.Debug(ctx, "Getting load balancer with forwarding rules", map[string]any{"load_balancer_id": loadBalancerID})
-	loadBalancer, resp, err := r.client.LoadBalancers.Get(ctx, loadBalancerID)
+	loadBalancer, resp, err := r.client.LoadBalancers.Get(helper.WithReadCache(ctx), loadBalancerID)
 	if err != nil {
 		if resp != nil && resp.StatusCode == http.StatusNotFound {
 			// if the load balancer (and included forwarding rules) is somehow already destroyed, mark as successfully gone
@@ -194,41 +215,26 @@ func (r *loadBalancerForwardingRuleResource) Read(ctx context.Context, request r
 	}
 	tflog.Debug(ctx, "Got load balancer with forwarding rules", map[string]any{"data": loadBalancer})
 
-	var forwardingRule *xelon.LoadBalancerForwardingRule
-	forwardingRuleID := data.ID.ValueString()
-	for _, lbForwardingRule := range loadBalancer.ForwardingRules {
-		if lbForwardingRule.ID == forwardingRuleID {
-			forwardingRule = &lbForwardingRule
-			break
-		}
-	}
+	forwardingRule := findLoadBalancerForwardingRuleByID(loadBalancer.ForwardingRules, data.ID.ValueString())
 	if forwardingRule == nil {
 		// if the forwarding rule is somehow already destroyed, mark as successfully gone
 		response.State.RemoveResource(ctx)
 		return
 	}
 
-	// map response body to attributes
-	if len(forwardingRule.Ports) == 2 {
-		data.FromPort = types.Int64Value(int64(forwardingRule.Ports[0]))
-		data.ToPort = types.Int64Value(int64(forwardingRule.Ports[1]))
-	} else {
-		tflog.Warn(ctx, "Fallback to defined ports in configuration, because port count from backend API is not correct",
-			map[string]any{"ports": forwardingRule.Ports},
-		)
-	}
-	ipAddresses := make([]string, 0, len(forwardingRule.IPAddresses))
-	ipAddresses = append(ipAddresses, forwardingRule.IPAddresses...)
-	data.ID = types.StringValue(forwardingRule.ID)
-	data.IPAddresses, diags = types.SetValueFrom(ctx, types.StringType, ipAddresses)
+	diags = data.fromAPI(ctx, loadBalancerID, forwardingRule)
 	response.Diagnostics.Append(diags...)
-	data.LoadBalancerID = types.StringValue(loadBalancerID)
 
 	diags = response.State.Set(ctx, &data)
 	response.Diagnostics.Append(diags...)
+	setResourceIdentity(ctx, response.Identity, loadBalancerForwardingRuleResourceIdentityModel{ID: data.ID, LoadBalancerID: data.LoadBalancerID}, &response.Diagnostics)
 }
 
 func (r *loadBalancerForwardingRuleResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	if r.checkReadOnly("update", &response.Diagnostics) {
+		return
+	}
+
 	var data loadBalancerForwardingRuleResourceModel
 
 	// read plan data into the model
@@ -275,27 +281,19 @@ func (r *loadBalancerForwardingRuleResource) Update(ctx context.Context, request
 		"data":               forwardingRule,
 	})
 
-	// map response body to attributes
-	if len(forwardingRule.Ports) == 2 {
-		data.FromPort = types.Int64Value(int64(forwardingRule.Ports[0]))
-		data.ToPort = types.Int64Value(int64(forwardingRule.Ports[1]))
-	} else {
-		tflog.Warn(ctx, "Fallback to defined ports in configuration, because port count from backend API is not correct",
-			map[string]any{"ports": forwardingRule.Ports},
-		)
-	}
-	ipAddresses = make([]string, 0, len(forwardingRule.IPAddresses))
-	ipAddresses = append(ipAddresses, forwardingRule.IPAddresses...)
-	data.ID = types.StringValue(forwardingRule.ID)
-	data.IPAddresses, diags = types.SetValueFrom(ctx, types.StringType, ipAddresses)
+	diags = data.fromAPI(ctx, loadBalancerID, forwardingRule)
 	response.Diagnostics.Append(diags...)
-	data.LoadBalancerID = types.StringValue(loadBalancerID)
 
 	diags = response.State.Set(ctx, &data)
 	response.Diagnostics.Append(diags...)
+	setResourceIdentity(ctx, response.Identity, loadBalancerForwardingRuleResourceIdentityModel{ID: data.ID, LoadBalancerID: data.LoadBalancerID}, &response.Diagnostics)
 }
 
 func (r *loadBalancerForwardingRuleResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	if r.checkReadOnly("delete", &response.Diagnostics) {
+		return
+	}
+
 	var data loadBalancerForwardingRuleResourceModel
 
 	// read state data into the model
@@ -321,3 +319,97 @@ func (r *loadBalancerForwardingRuleResource) Delete(ctx context.Context, request
 		"load_balancer_id":   loadBalancerID,
 	})
 }
+
+func (r *loadBalancerForwardingRuleResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	var identity loadBalancerForwardingRuleResourceIdentityModel
+	if request.ID != "" {
+		loadBalancerID, forwardingRuleID, err := parseForwardingRuleCompositeID(request.ID, "load_balancer_id")
+		if err != nil {
+			response.Diagnostics.AddError("Invalid import identifier", err.Error())
+			return
+		}
+		identity = loadBalancerForwardingRuleResourceIdentityModel{LoadBalancerID: types.StringValue(loadBalancerID), ID: types.StringValue(forwardingRuleID)}
+	} else {
+		response.Diagnostics.Append(request.Identity.Get(ctx, &identity)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+	loadBalancerID := identity.LoadBalancerID.ValueString()
+	forwardingRuleID := identity.ID.ValueString()
+
+	tflog.Debug(ctx, "Getting load balancer with forwarding rules", map[string]any{"load_balancer_id": loadBalancerID})
+	loadBalancer, resp, err := r.client.LoadBalancers.Get(helper.WithReadCache(ctx), loadBalancerID)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			response.Diagnostics.AddError(
+				"Unable to import forwarding rule",
+				fmt.Sprintf("Load balancer %s does not exist.", loadBalancerID),
+			)
+			return
+		}
+		response.Diagnostics.AddError("Unable to get load balancer with forwarding rules", err.Error())
+		return
+	}
+	tflog.Debug(ctx, "Got load balancer with forwarding rules", map[string]any{"data": loadBalancer})
+
+	forwardingRule := findLoadBalancerForwardingRuleByID(loadBalancer.ForwardingRules, forwardingRuleID)
+	if forwardingRule == nil {
+		response.Diagnostics.AddError(
+			"Unable to import forwarding rule",
+			fmt.Sprintf("Forwarding rule %s does not exist in load balancer %s.", forwardingRuleID, loadBalancerID),
+		)
+		return
+	}
+	if len(forwardingRule.Ports) != 2 {
+		response.Diagnostics.AddError(
+			"Unable to import forwarding rule",
+			fmt.Sprintf("Expected forwarding rule %s to have exactly two ports, got %d.", forwardingRuleID, len(forwardingRule.Ports)),
+		)
+		return
+	}
+
+	var data loadBalancerForwardingRuleResourceModel
+	diags := data.fromAPI(ctx, loadBalancerID, forwardingRule)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	diags = response.State.Set(ctx, &data)
+	response.Diagnostics.Append(diags...)
+	setResourceIdentity(ctx, response.Identity, identity, &response.Diagnostics)
+}
+
+func (m *loadBalancerForwardingRuleResourceModel) fromAPI(ctx context.Context, loadBalancerID string, forwardingRule *xelon.LoadBalancerForwardingRule) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	ipAddresses := make([]string, 0, len(forwardingRule.IPAddresses))
+	ipAddresses = append(ipAddresses, forwardingRule.IPAddresses...)
+	ipAddressesValue, d := types.SetValueFrom(ctx, types.StringType, ipAddresses)
+	diags.Append(d...)
+
+	if len(forwardingRule.Ports) == 2 {
+		m.FromPort = types.Int64Value(int64(forwardingRule.Ports[0]))
+		m.ToPort = types.Int64Value(int64(forwardingRule.Ports[1]))
+	} else {
+		tflog.Warn(ctx, "Fallback to defined ports in configuration, because port count from backend API is not correct",
+			map[string]any{"ports": forwardingRule.Ports},
+		)
+	}
+
+	m.ID = types.StringValue(forwardingRule.ID)
+	m.IPAddresses = ipAddressesValue
+	m.LoadBalancerID = types.StringValue(loadBalancerID)
+
+	return diags
+}
+
+func findLoadBalancerForwardingRuleByID(forwardingRules []xelon.LoadBalancerForwardingRule, forwardingRuleID string) *xelon.LoadBalancerForwardingRule {
+	for i := range forwardingRules {
+		if forwardingRules[i].ID == forwardingRuleID {
+			return &forwardingRules[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,153 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Xelon-AG/xelon-sdk-go/xelon"
+)
+
+func TestResourceXelonLoadBalancerForwardingRule_RuleLookup_FindByID(t *testing.T) {
+	forwardingRules := []xelon.LoadBalancerForwardingRule{
+		{ID: "rule-100", Ports: []int{80, 8080}},
+		{ID: "rule-200", Ports: []int{443, 8443}},
+	}
+
+	assert.Equal(t, []int{443, 8443}, findLoadBalancerForwardingRuleByID(forwardingRules, "rule-200").Ports)
+	assert.Nil(t, findLoadBalancerForwardingRuleByID(forwardingRules, "rule-300"))
+}
+
+func TestResourceXelonLoadBalancerForwardingRule_Model_FromAPI(t *testing.T) {
+	var model loadBalancerForwardingRuleResourceModel
+
+	diags := model.fromAPI(context.Background(), "lb-123", &xelon.LoadBalancerForwardingRule{
+		ID:          "rule-456",
+		IPAddresses: []string{"10.0.0.50"},
+		Ports:       []int{443, 8443},
+	})
+
+	require.False(t, diags.HasError())
+	expectedIPAddresses, _ := types.SetValueFrom(context.Background(), types.StringType, []string{"10.0.0.50"})
+	assert.Equal(t, loadBalancerForwardingRuleResourceModel{
+		FromPort:       types.Int64Value(443),
+		ID:             types.StringValue("rule-456"),
+		IPAddresses:    expectedIPAddresses,
+		LoadBalancerID: types.StringValue("lb-123"),
+		ToPort:         types.Int64Value(8443),
+	}, model)
+}
+
+func TestResourceXelonLoadBalancerForwardingRule_Model_FromAPI_KeepsPortsOnInvalidPortCount(t *testing.T) {
+	model := loadBalancerForwardingRuleResourceModel{
+		FromPort: types.Int64Value(80),
+		ToPort:   types.Int64Value(8080),
+	}
+
+	diags := model.fromAPI(context.Background(), "lb-123", &xelon.LoadBalancerForwardingRule{
+		ID:    "rule-456",
+		Ports: []int{80},
+	})
+
+	require.False(t, diags.HasError())
+	assert.Equal(t, types.Int64Value(80), model.FromPort)
+	assert.Equal(t, types.Int64Value(8080), model.ToPort)
+}
+
+func TestResourceXelonLoadBalancerForwardingRule_Import(t *testing.T) {
+	server := newFakeXelonServer(t)
+	server.Mutate(func(s *fakeXelonServer) {
+		s.loadBalancers["lb-123"] = &xelon.LoadBalancer{
+			ForwardingRules: []xelon.LoadBalancerForwardingRule{{
+				ID:          "rule-456",
+				IPAddresses: []string{"10.0.0.50"},
+				Ports:       []int{443, 8080},
+			}},
+			ID:   "lb-123",
+			Name: "imported",
+		}
+	})
+	config := server.ProviderConfig() + testResourceXelonLoadBalancerForwardingRuleConfig("lb-123")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:        config,
+				ResourceName:  "xelon_load_balancer_forwarding_rule.test",
+				ImportState:   true,
+				ImportStateId: "rule-456",
+				ExpectError:   regexp.MustCompile(`expected format: <load_balancer_id>/<rule_id>`),
+			},
+			{
+				Config:        config,
+				ResourceName:  "xelon_load_balancer_forwarding_rule.test",
+				ImportState:   true,
+				ImportStateId: "lb-999/rule-456",
+				ExpectError:   regexp.MustCompile(`Load balancer lb-999 does not exist`),
+			},
+			{
+				Config:        config,
+				ResourceName:  "xelon_load_balancer_forwarding_rule.test",
+				ImportState:   true,
+				ImportStateId: "lb-123/rule-999",
+				ExpectError:   regexp.MustCompile(`Forwarding rule rule-999 does not exist in load balancer lb-123`),
+			},
+			{
+				Config:             config,
+				ResourceName:       "xelon_load_balancer_forwarding_rule.test",
+				ImportState:        true,
+				ImportStateId:      "lb-123/rule-456",
+				ImportStatePersist: true,
+			},
+			// imported state must match the configuration without changes
+			{
+				Config: config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"xelon_load_balancer_forwarding_rule.test",
+						tfjsonpath.New("from_port"),
+						knownvalue.Int64Exact(443),
+					),
+					statecheck.ExpectKnownValue(
+						"xelon_load_balancer_forwarding_rule.test",
+						tfjsonpath.New("id"),
+						knownvalue.StringExact("rule-456"),
+					),
+					statecheck.ExpectKnownValue(
+						"xelon_load_balancer_forwarding_rule.test",
+						tfjsonpath.New("to_port"),
+						knownvalue.Int64Exact(8080),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testResourceXelonLoadBalancerForwardingRuleConfig(loadBalancerID string) string {
+	return fmt.Sprintf(`
+resource "xelon_load_balancer_forwarding_rule" "test" {
+  load_balancer_id = %[1]q
+
+  ipv4_addresses = ["10.0.0.50"]
+  from_port      = 443
+  to_port        = 8080
+}
+`, loadBalancerID)
+}
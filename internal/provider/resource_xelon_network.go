@@ -21,10 +21,15 @@ var (
 	_ resource.Resource                = (*networkResource)(nil)
 	_ resource.ResourceWithConfigure   = (*networkResource)(nil)
 	_ resource.ResourceWithImportState = (*networkResource)(nil)
+	_ resource.ResourceWithIdentity    = (*networkResource)(nil)
+	_ resourceWithProviderDefaults     = (*networkResource)(nil)
 )
 
 // networkResource is the network resource implementation.
 type networkResource struct {
+	withProviderDefaults
+	withReadOnlyMode
+
 	client *xelon.Client
 }
 
@@ -59,8 +64,12 @@ The network resource allows you to manage Xelon networks.
 		Version: 0,
 		Attributes: map[string]schema.Attribute{
 			"cloud_id": schema.StringAttribute{
-				MarkdownDescription: "The ID of the cloud.",
-				Required:            true,
+				MarkdownDescription: "The ID of the cloud. Defaults to the provider `default_cloud_id`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					r.cloudIDPlanModifier(true),
+				},
 			},
 			"dns_primary": schema.StringAttribute{
 				MarkdownDescription: "The primary DNS server address. Must be specified if network type is `LAN`.",
@@ -118,11 +127,11 @@ The network resource allows you to manage Xelon networks.
 				Required:            true,
 			},
 			"tenant_id": schema.StringAttribute{
-				MarkdownDescription: "The tenant ID to whom the network belongs.",
+				MarkdownDescription: "The tenant ID to whom the network belongs. Defaults to the provider `default_tenant_id`.",
 				Optional:            true,
 				Computed:            true,
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.UseStateForUnknown(),
+					r.tenantIDPlanModifier(false),
 				},
 			},
 			"type": schema.StringAttribute{
@@ -151,6 +160,10 @@ The network resource allows you to manage Xelon networks.
 	}
 }
 
+func (r *networkResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, response *resource.IdentitySchemaResponse) {
+	response.IdentitySchema = idIdentitySchema("The ID of the network.")
+}
+
 func (r *networkResource) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
 	if request.ProviderData == nil {
 		return
@@ -169,6 +182,10 @@ func (r *networkResource) Configure(_ context.Context, request resource.Configur
 }
 
 func (r *networkResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	if r.checkReadOnly("create", &response.Diagnostics) {
+		return
+	}
+
 	var data networkResourceModel
 
 	// read plan data into the model
@@ -273,6 +290,7 @@ func (r *networkResource) Create(ctx context.Context, request resource.CreateReq
 
 	diags = response.State.Set(ctx, &data)
 	response.Diagnostics.Append(diags...)
+	setResourceIdentity(ctx, response.Identity, idResourceIdentityModel{ID: data.ID}, &response.Diagnostics)
 }
 
 func (r *networkResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
@@ -311,9 +329,14 @@ func (r *networkResource) Read(ctx context.Context, request resource.ReadRequest
 
 	diags = response.State.Set(ctx, &data)
 	response.Diagnostics.Append(diags...)
+	setResourceIdentity(ctx, response.Identity, idResourceIdentityModel{ID: data.ID}, &response.Diagnostics)
 }
 
 func (r *networkResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	if r.checkReadOnly("update", &response.Diagnostics) {
+		return
+	}
+
 	var data networkResourceModel
 
 	// read plan data into the model
@@ -391,9 +414,14 @@ func (r *networkResource) Update(ctx context.Context, request resource.UpdateReq
 
 	diags = response.State.Set(ctx, &data)
 	response.Diagnostics.Append(diags...)
+	setResourceIdentity(ctx, response.Identity, idResourceIdentityModel{ID: data.ID}, &response.Diagnostics)
 }
 
 func (r *networkResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	if r.checkReadOnly("delete", &response.Diagnostics) {
+		return
+	}
+
 	var data networkResourceModel
 
 	// read state data into the model
@@ -414,5 +442,5 @@ func (r *networkResource) Delete(ctx context.Context, request resource.DeleteReq
 }
 
 func (r *networkResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), request, response)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), request, response)
 }
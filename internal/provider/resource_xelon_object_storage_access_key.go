@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -23,10 +24,13 @@ var (
 	_ resource.Resource                = (*objectStorageAccessKeyResource)(nil)
 	_ resource.ResourceWithConfigure   = (*objectStorageAccessKeyResource)(nil)
 	_ resource.ResourceWithImportState = (*objectStorageAccessKeyResource)(nil)
+	_ resource.ResourceWithIdentity    = (*objectStorageAccessKeyResource)(nil)
 )
 
 // objectStorageAccessKeyResource is the object storage access key resource implementation.
 type objectStorageAccessKeyResource struct {
+	withReadOnlyMode
+
 	client *xelon.Client
 }
 
@@ -39,6 +43,12 @@ type objectStorageAccessKeyResourceModel struct {
 	ObjectStorageUserID types.String `tfsdk:"user_id"`
 }
 
+// objectStorageAccessKeyResourceIdentityModel maps the object storage access key resource identity schema data.
+type objectStorageAccessKeyResourceIdentityModel struct {
+	ID                  types.String `tfsdk:"id"`
+	ObjectStorageUserID types.String `tfsdk:"user_id"`
+}
+
 func NewObjectStorageAccessKeyResource() resource.Resource {
 	return &objectStorageAccessKeyResource{}
 }
@@ -91,6 +101,21 @@ These credentials are used to authenticate applications and tools against Xelon
 	}
 }
 
+func (r *objectStorageAccessKeyResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, response *resource.IdentitySchemaResponse) {
+	response.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "ID of the object storage access key.",
+				RequiredForImport: true,
+			},
+			"user_id": identityschema.StringAttribute{
+				Description:       "ID of the object storage user owning this access key.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *objectStorageAccessKeyResource) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
 	if request.ProviderData == nil {
 		return
@@ -109,6 +134,10 @@ func (r *objectStorageAccessKeyResource) Configure(_ context.Context, request re
 }
 
 func (r *objectStorageAccessKeyResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	if r.checkReadOnly("create", &response.Diagnostics) {
+		return
+	}
+
 	var data objectStorageAccessKeyResourceModel
 
 	// read plan data into the model
@@ -141,6 +170,7 @@ func (r *objectStorageAccessKeyResource) Create(ctx context.Context, request res
 	data.SecretAccessKey = types.StringValue(token.SecretKey)
 	diags = response.State.Set(ctx, &data)
 	response.Diagnostics.Append(diags...)
+	setResourceIdentity(ctx, response.Identity, objectStorageAccessKeyResourceIdentityModel{ID: data.ID, ObjectStorageUserID: data.ObjectStorageUserID}, &response.Diagnostics)
 }
 
 func (r *objectStorageAccessKeyResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
@@ -191,6 +221,7 @@ func (r *objectStorageAccessKeyResource) Read(ctx context.Context, request resou
 	// map API response to Terraform state
 	diags = response.State.Set(ctx, &data)
 	response.Diagnostics.Append(diags...)
+	setResourceIdentity(ctx, response.Identity, objectStorageAccessKeyResourceIdentityModel{ID: data.ID, ObjectStorageUserID: data.ObjectStorageUserID}, &response.Diagnostics)
 }
 
 func (r *objectStorageAccessKeyResource) Update(context.Context, resource.UpdateRequest, *resource.UpdateResponse) {
@@ -198,6 +229,10 @@ func (r *objectStorageAccessKeyResource) Update(context.Context, resource.Update
 }
 
 func (r *objectStorageAccessKeyResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	if r.checkReadOnly("delete", &response.Diagnostics) {
+		return
+	}
+
 	var data objectStorageAccessKeyResourceModel
 
 	// read state data into the model
@@ -232,17 +267,24 @@ func (r *objectStorageAccessKeyResource) Delete(ctx context.Context, request res
 }
 
 func (r *objectStorageAccessKeyResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
-	parts := strings.SplitN(request.ID, "/", 2)
-	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
-		response.Diagnostics.AddError("Invalid import identifier", "Expected format: <user_id>/<id>")
-		return
+	var identity objectStorageAccessKeyResourceIdentityModel
+	if request.ID != "" {
+		parts := strings.SplitN(request.ID, "/", 2)
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			response.Diagnostics.AddError("Invalid import identifier", "Expected format: <user_id>/<id>")
+			return
+		}
+		identity = objectStorageAccessKeyResourceIdentityModel{ID: types.StringValue(parts[1]), ObjectStorageUserID: types.StringValue(parts[0])}
+	} else {
+		response.Diagnostics.Append(request.Identity.Get(ctx, &identity)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
 	}
 
-	userID := parts[0]
-	tokenID := parts[1]
-
-	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("user_id"), userID)...)
-	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("id"), tokenID)...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("user_id"), identity.ObjectStorageUserID)...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("id"), identity.ID)...)
+	setResourceIdentity(ctx, response.Identity, identity, &response.Diagnostics)
 }
 
 func (m *objectStorageAccessKeyResourceModel) fromAPI(objectStorageUserToken *xelon.ObjectStorageUserToken, objectStorageUserID string) {
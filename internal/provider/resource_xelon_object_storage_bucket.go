@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -29,6 +30,7 @@ var (
 	_ resource.Resource                   = (*objectStorageBucketResource)(nil)
 	_ resource.ResourceWithConfigure      = (*objectStorageBucketResource)(nil)
 	_ resource.ResourceWithImportState    = (*objectStorageBucketResource)(nil)
+	_ resource.ResourceWithIdentity       = (*objectStorageBucketResource)(nil)
 	_ resource.ResourceWithModifyPlan     = (*objectStorageBucketResource)(nil)
 	_ resource.ResourceWithValidateConfig = (*objectStorageBucketResource)(nil)
 )
@@ -40,6 +42,8 @@ const (
 
 // objectStorageBucketResource is the object storage bucket resource implementation.
 type objectStorageBucketResource struct {
+	withReadOnlyMode
+
 	client *xelon.Client
 }
 
@@ -57,6 +61,12 @@ type objectStorageBucketResourceModel struct {
 	VersioningEnabled        types.Bool   `tfsdk:"versioning_enabled"`
 }
 
+// objectStorageBucketResourceIdentityModel maps the object storage bucket resource identity schema data.
+type objectStorageBucketResourceIdentityModel struct {
+	Name                types.String `tfsdk:"name"`
+	ObjectStorageUserID types.String `tfsdk:"user_id"`
+}
+
 func NewObjectStorageBucketResource() resource.Resource {
 	return &objectStorageBucketResource{}
 }
@@ -161,6 +171,21 @@ Object Lock is optional and defaults to disabled. Object Lock can only be enable
 	}
 }
 
+func (r *objectStorageBucketResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, response *resource.IdentitySchemaResponse) {
+	response.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"name": identityschema.StringAttribute{
+				Description:       "The name of the bucket.",
+				RequiredForImport: true,
+			},
+			"user_id": identityschema.StringAttribute{
+				Description:       "The ID of the object storage user that owns the bucket.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *objectStorageBucketResource) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
 	if request.ProviderData == nil {
 		return
@@ -179,6 +204,10 @@ func (r *objectStorageBucketResource) Configure(_ context.Context, request resou
 }
 
 func (r *objectStorageBucketResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	if r.checkReadOnly("create", &response.Diagnostics) {
+		return
+	}
+
 	var data objectStorageBucketResourceModel
 
 	diags := request.Plan.Get(ctx, &data)
@@ -267,6 +296,7 @@ func (r *objectStorageBucketResource) Create(ctx context.Context, request resour
 
 	diags = response.State.Set(ctx, &data)
 	response.Diagnostics.Append(diags...)
+	setResourceIdentity(ctx, response.Identity, objectStorageBucketResourceIdentityModel{Name: data.Name, ObjectStorageUserID: data.ObjectStorageUserID}, &response.Diagnostics)
 }
 
 func (r *objectStorageBucketResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
@@ -312,9 +342,14 @@ func (r *objectStorageBucketResource) Read(ctx context.Context, request resource
 
 	diags = response.State.Set(ctx, &data)
 	response.Diagnostics.Append(diags...)
+	setResourceIdentity(ctx, response.Identity, objectStorageBucketResourceIdentityModel{Name: data.Name, ObjectStorageUserID: data.ObjectStorageUserID}, &response.Diagnostics)
 }
 
 func (r *objectStorageBucketResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	if r.checkReadOnly("update", &response.Diagnostics) {
+		return
+	}
+
 	var plan objectStorageBucketResourceModel
 	var state objectStorageBucketResourceModel
 
@@ -385,9 +420,14 @@ func (r *objectStorageBucketResource) Update(ctx context.Context, request resour
 
 	diags = response.State.Set(ctx, &plan)
 	response.Diagnostics.Append(diags...)
+	setResourceIdentity(ctx, response.Identity, objectStorageBucketResourceIdentityModel{Name: plan.Name, ObjectStorageUserID: plan.ObjectStorageUserID}, &response.Diagnostics)
 }
 
 func (r *objectStorageBucketResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	if r.checkReadOnly("delete", &response.Diagnostics) {
+		return
+	}
+
 	var data objectStorageBucketResourceModel
 
 	diags := request.State.Get(ctx, &data)
@@ -428,14 +468,24 @@ func (r *objectStorageBucketResource) Delete(ctx context.Context, request resour
 }
 
 func (r *objectStorageBucketResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
-	userID, bucketName, err := parseObjectStorageBucketImportID(request.ID)
-	if err != nil {
-		response.Diagnostics.AddError("Invalid import identifier", "Expected format: <user-id>/<bucket-name>")
-		return
+	var identity objectStorageBucketResourceIdentityModel
+	if request.ID != "" {
+		userID, bucketName, err := parseObjectStorageBucketImportID(request.ID)
+		if err != nil {
+			response.Diagnostics.AddError("Invalid import identifier", "Expected format: <user-id>/<bucket-name>")
+			return
+		}
+		identity = objectStorageBucketResourceIdentityModel{Name: types.StringValue(bucketName), ObjectStorageUserID: types.StringValue(userID)}
+	} else {
+		response.Diagnostics.Append(request.Identity.Get(ctx, &identity)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
 	}
 
-	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("user_id"), userID)...)
-	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("name"), bucketName)...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("user_id"), identity.ObjectStorageUserID)...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("name"), identity.Name)...)
+	setResourceIdentity(ctx, response.Identity, identity, &response.Diagnostics)
 }
 
 func (r *objectStorageBucketResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -25,10 +26,15 @@ var (
 	_ resource.Resource                = (*objectStorageUserResource)(nil)
 	_ resource.ResourceWithConfigure   = (*objectStorageUserResource)(nil)
 	_ resource.ResourceWithImportState = (*objectStorageUserResource)(nil)
+	_ resource.ResourceWithIdentity    = (*objectStorageUserResource)(nil)
+	_ resourceWithProviderDefaults     = (*objectStorageUserResource)(nil)
 )
 
 // objectStorageUserResource is the object storage user resource implementation.
 type objectStorageUserResource struct {
+	withProviderDefaults
+	withReadOnlyMode
+
 	client *xelon.Client
 }
 
@@ -43,6 +49,12 @@ type objectStorageUserResourceModel struct {
 	TenantID                 types.String `tfsdk:"tenant_id"`
 }
 
+// objectStorageUserResourceIdentityModel maps the object storage user resource identity schema data.
+type objectStorageUserResourceIdentityModel struct {
+	ID     types.String `tfsdk:"id"`
+	Region types.String `tfsdk:"region"`
+}
+
 func NewObjectStorageUserResource() resource.Resource {
 	return &objectStorageUserResource{}
 }
@@ -101,12 +113,12 @@ Users are used to create access keys and manage access to Object Storage buckets
 				},
 			},
 			"tenant_id": schema.StringAttribute{
-				MarkdownDescription: "The ID of the tenant that owns the object storage user.",
+				MarkdownDescription: "The ID of the tenant that owns the object storage user. Defaults to the provider `default_tenant_id`.",
 				Optional:            true,
 				Computed:            true,
 				PlanModifiers: []planmodifier.String{
+					r.tenantIDPlanModifier(false),
 					stringplanmodifier.RequiresReplace(),
-					stringplanmodifier.UseStateForUnknown(),
 				},
 				Validators: []validator.String{
 					stringvalidator.LengthAtLeast(1),
@@ -116,6 +128,21 @@ Users are used to create access keys and manage access to Object Storage buckets
 	}
 }
 
+func (r *objectStorageUserResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, response *resource.IdentitySchemaResponse) {
+	response.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "The ID of the object storage user.",
+				RequiredForImport: true,
+			},
+			"region": identityschema.StringAttribute{
+				Description:       "The region of the object storage user.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *objectStorageUserResource) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
 	if request.ProviderData == nil {
 		return
@@ -134,6 +161,10 @@ func (r *objectStorageUserResource) Configure(_ context.Context, request resourc
 }
 
 func (r *objectStorageUserResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	if r.checkReadOnly("create", &response.Diagnostics) {
+		return
+	}
+
 	var data objectStorageUserResourceModel
 
 	// read plan data into the model
@@ -200,6 +231,7 @@ func (r *objectStorageUserResource) Create(ctx context.Context, request resource
 
 	diags = response.State.Set(ctx, &data)
 	response.Diagnostics.Append(diags...)
+	setResourceIdentity(ctx, response.Identity, objectStorageUserResourceIdentityModel{ID: data.ID, Region: data.Region}, &response.Diagnostics)
 }
 
 func (r *objectStorageUserResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
@@ -238,9 +270,14 @@ func (r *objectStorageUserResource) Read(ctx context.Context, request resource.R
 
 	diags = response.State.Set(ctx, &data)
 	response.Diagnostics.Append(diags...)
+	setResourceIdentity(ctx, response.Identity, objectStorageUserResourceIdentityModel{ID: data.ID, Region: data.Region}, &response.Diagnostics)
 }
 
 func (r *objectStorageUserResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	if r.checkReadOnly("update", &response.Diagnostics) {
+		return
+	}
+
 	var data objectStorageUserResourceModel
 
 	// read plan data into the model
@@ -300,9 +337,14 @@ func (r *objectStorageUserResource) Update(ctx context.Context, request resource
 
 	diags = response.State.Set(ctx, &data)
 	response.Diagnostics.Append(diags...)
+	setResourceIdentity(ctx, response.Identity, objectStorageUserResourceIdentityModel{ID: data.ID, Region: data.Region}, &response.Diagnostics)
 }
 
 func (r *objectStorageUserResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	if r.checkReadOnly("delete", &response.Diagnostics) {
+		return
+	}
+
 	var data objectStorageUserResourceModel
 
 	// read state data into the model
@@ -326,14 +368,24 @@ func (r *objectStorageUserResource) Delete(ctx context.Context, request resource
 }
 
 func (r *objectStorageUserResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
-	region, objectStorageUserID, err := parseObjectStorageUserImportID(request.ID)
-	if err != nil {
-		response.Diagnostics.AddError("Invalid import identifier", "Expected format: <region>/<id>")
-		return
+	var identity objectStorageUserResourceIdentityModel
+	if request.ID != "" {
+		region, objectStorageUserID, err := parseObjectStorageUserImportID(request.ID)
+		if err != nil {
+			response.Diagnostics.AddError("Invalid import identifier", "Expected format: <region>/<id>")
+			return
+		}
+		identity = objectStorageUserResourceIdentityModel{ID: types.StringValue(objectStorageUserID), Region: types.StringValue(region)}
+	} else {
+		response.Diagnostics.Append(request.Identity.Get(ctx, &identity)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
 	}
 
-	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("region"), region)...)
-	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("id"), objectStorageUserID)...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("region"), identity.Region)...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("id"), identity.ID)...)
+	setResourceIdentity(ctx, response.Identity, identity, &response.Diagnostics)
 }
 
 func (m *objectStorageUserResourceModel) fromAPI(ctx context.Context, objectStorageUser *xelon.ObjectStorageUser, region string) diag.Diagnostics {
@@ -3,7 +3,9 @@ package provider
 import (
 	"context"
 	"net/http"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -21,22 +23,32 @@ var (
 	_ resource.Resource                = (*persistentStorageResource)(nil)
 	_ resource.ResourceWithConfigure   = (*persistentStorageResource)(nil)
 	_ resource.ResourceWithImportState = (*persistentStorageResource)(nil)
+	_ resource.ResourceWithIdentity    = (*persistentStorageResource)(nil)
+	_ resourceWithProviderDefaults     = (*persistentStorageResource)(nil)
+)
+
+const (
+	defaultPersistentStorageTimeout = 10 * time.Minute
 )
 
 // persistentStorageResource is the persistent storage resource implementation.
 type persistentStorageResource struct {
+	withProviderDefaults
+	withReadOnlyMode
+
 	client *xelon.Client
 }
 
 // persistentStorageResourceModel maps the persistent storage resource schema data.
 type persistentStorageResourceModel struct {
-	CloudID  types.String `tfsdk:"cloud_id"`
-	DeviceID types.String `tfsdk:"device_id"`
-	ID       types.String `tfsdk:"id"`
-	Name     types.String `tfsdk:"name"`
-	Size     types.Int64  `tfsdk:"size"`
-	TenantID types.String `tfsdk:"tenant_id"`
-	UUID     types.String `tfsdk:"uuid"`
+	CloudID  types.String   `tfsdk:"cloud_id"`
+	DeviceID types.String   `tfsdk:"device_id"`
+	ID       types.String   `tfsdk:"id"`
+	Name     types.String   `tfsdk:"name"`
+	Size     types.Int64    `tfsdk:"size"`
+	TenantID types.String   `tfsdk:"tenant_id"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+	UUID     types.String   `tfsdk:"uuid"`
 }
 
 func NewPersistentStorageResource() resource.Resource {
@@ -47,7 +59,7 @@ func (r *persistentStorageResource) Metadata(_ context.Context, _ resource.Metad
 	response.TypeName = "xelon_persistent_storage"
 }
 
-func (r *persistentStorageResource) Schema(_ context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+func (r *persistentStorageResource) Schema(ctx context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
 	response.Schema = schema.Schema{
 		MarkdownDescription: `
 The persistent storage resource allows you to manage Xelon persistent storages.
@@ -57,12 +69,12 @@ Persistent storages are detachable storages for your Devices and clusters.
 		Version: 0,
 		Attributes: map[string]schema.Attribute{
 			"cloud_id": schema.StringAttribute{
-				MarkdownDescription: "The ID of the cloud.",
+				MarkdownDescription: "The ID of the cloud. Defaults to the provider `default_cloud_id`.",
 				Optional:            true,
 				Computed:            true,
 				PlanModifiers: []planmodifier.String{
+					r.cloudIDPlanModifier(false),
 					stringplanmodifier.RequiresReplace(),
-					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
 			"device_id": schema.StringAttribute{
@@ -90,14 +102,22 @@ Persistent storages are detachable storages for your Devices and clusters.
 				},
 			},
 			"tenant_id": schema.StringAttribute{
-				MarkdownDescription: "The tenant ID to whom the persistent storage belongs.",
+				MarkdownDescription: "The tenant ID to whom the persistent storage belongs. Defaults to the provider `default_tenant_id`.",
 				Optional:            true,
 				Computed:            true,
 				PlanModifiers: []planmodifier.String{
+					r.tenantIDPlanModifier(false),
 					stringplanmodifier.RequiresReplace(),
-					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create:            true,
+				CreateDescription: "Applies to each step waiting for the resource. Defaults to 10m.",
+				Update:            true,
+				UpdateDescription: "Applies to each step waiting for the resource. Defaults to 10m.",
+				Delete:            true,
+				DeleteDescription: "Defaults to 10m.",
+			}),
 			"uuid": schema.StringAttribute{
 				MarkdownDescription: "The unique identifier for the persistent storage device.",
 				Computed:            true,
@@ -109,6 +129,10 @@ Persistent storages are detachable storages for your Devices and clusters.
 	}
 }
 
+func (r *persistentStorageResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, response *resource.IdentitySchemaResponse) {
+	response.IdentitySchema = idIdentitySchema("The ID of the persistent storage.")
+}
+
 func (r *persistentStorageResource) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
 	if request.ProviderData == nil {
 		return
@@ -127,6 +151,10 @@ func (r *persistentStorageResource) Configure(_ context.Context, request resourc
 }
 
 func (r *persistentStorageResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	if r.checkReadOnly("create", &response.Diagnostics) {
+		return
+	}
+
 	var data persistentStorageResourceModel
 
 	// read plan data into the model
@@ -136,6 +164,13 @@ func (r *persistentStorageResource) Create(ctx context.Context, request resource
 		return
 	}
 
+	// configure timeout
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultPersistentStorageTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	createRequest := &xelon.PersistentStorageCreateRequest{
 		Name: data.Name.ValueString(),
 		Size: int(data.Size.ValueInt64()),
@@ -161,7 +196,7 @@ func (r *persistentStorageResource) Create(ctx context.Context, request resource
 	persistentStorageID := createdPersistentStorage.ID
 
 	tflog.Info(ctx, "Waiting for persistent storage to be formatted", map[string]any{"persistent_storage_id": persistentStorageID})
-	err = helper.WaitPersistentStorageStateFormatted(ctx, r.client, persistentStorageID)
+	err = helper.WaitPersistentStorageStateFormatted(ctx, r.client, persistentStorageID, createTimeout)
 	if err != nil {
 		response.Diagnostics.AddError("Unable to wait for persistent storage to be formatted", err.Error())
 		return
@@ -169,7 +204,7 @@ func (r *persistentStorageResource) Create(ctx context.Context, request resource
 	tflog.Info(ctx, "Persistent storage is formatted", map[string]any{"persistent_storage_id": persistentStorageID})
 
 	tflog.Info(ctx, "Waiting for persistent storage to be ready", map[string]any{"persistent_storage_id": persistentStorageID})
-	err = helper.WaitPersistentStorageStateReady(ctx, r.client, persistentStorageID)
+	err = helper.WaitPersistentStorageStateReady(ctx, r.client, persistentStorageID, createTimeout)
 	if err != nil {
 		response.Diagnostics.AddError("Unable to wait for persistent storage to be ready", err.Error())
 		return
@@ -204,6 +239,7 @@ func (r *persistentStorageResource) Create(ctx context.Context, request resource
 
 	diags = response.State.Set(ctx, &data)
 	response.Diagnostics.Append(diags...)
+	setResourceIdentity(ctx, response.Identity, idResourceIdentityModel{ID: data.ID}, &response.Diagnostics)
 }
 
 func (r *persistentStorageResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
@@ -250,9 +286,14 @@ func (r *persistentStorageResource) Read(ctx context.Context, request resource.R
 
 	diags = response.State.Set(ctx, &data)
 	response.Diagnostics.Append(diags...)
+	setResourceIdentity(ctx, response.Identity, idResourceIdentityModel{ID: data.ID}, &response.Diagnostics)
 }
 
 func (r *persistentStorageResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	if r.checkReadOnly("update", &response.Diagnostics) {
+		return
+	}
+
 	var plan, state persistentStorageResourceModel
 
 	// read plan and state data into the model
@@ -262,6 +303,13 @@ func (r *persistentStorageResource) Update(ctx context.Context, request resource
 		return
 	}
 
+	// configure timeout
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultPersistentStorageTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	persistentStorageID := state.ID.ValueString()
 
 	if !plan.DeviceID.Equal(state.DeviceID) {
@@ -303,7 +351,7 @@ func (r *persistentStorageResource) Update(ctx context.Context, request resource
 		tflog.Debug(ctx, "Extended persistent storage size", map[string]any{"persistent_storage_id": persistentStorageID})
 
 		tflog.Info(ctx, "Waiting for persistent storage to be formatted after extension", map[string]any{"persistent_storage_id": persistentStorageID})
-		err = helper.WaitPersistentStorageStateFormatted(ctx, r.client, persistentStorageID)
+		err = helper.WaitPersistentStorageStateFormatted(ctx, r.client, persistentStorageID, updateTimeout)
 		if err != nil {
 			response.Diagnostics.AddError("Unable to wait for persistent storage to be formatted", err.Error())
 			return
@@ -321,11 +369,16 @@ func (r *persistentStorageResource) Update(ctx context.Context, request resource
 		plan.Size = types.Int64Value(int64(persistentStorage.Capacity))
 	}
 
-	diags := response.State.Set(ctx, &plan)
+	diags = response.State.Set(ctx, &plan)
 	response.Diagnostics.Append(diags...)
+	setResourceIdentity(ctx, response.Identity, idResourceIdentityModel{ID: plan.ID}, &response.Diagnostics)
 }
 
 func (r *persistentStorageResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	if r.checkReadOnly("delete", &response.Diagnostics) {
+		return
+	}
+
 	var data persistentStorageResourceModel
 
 	// read state data into the model
@@ -335,6 +388,15 @@ func (r *persistentStorageResource) Delete(ctx context.Context, request resource
 		return
 	}
 
+	// configure timeout
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultPersistentStorageTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	persistentStorageID := data.ID.ValueString()
 	tflog.Debug(ctx, "Deleting persistent storage", map[string]any{"persistent_storage_id": persistentStorageID})
 	_, err := r.client.PersistentStorages.Delete(ctx, persistentStorageID)
@@ -346,5 +408,5 @@ func (r *persistentStorageResource) Delete(ctx context.Context, request resource
 }
 
 func (r *persistentStorageResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), request, response)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), request, response)
 }
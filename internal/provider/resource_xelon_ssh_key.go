@@ -19,10 +19,13 @@ var (
 	_ resource.Resource                = (*sshKeyResource)(nil)
 	_ resource.ResourceWithConfigure   = (*sshKeyResource)(nil)
 	_ resource.ResourceWithImportState = (*sshKeyResource)(nil)
+	_ resource.ResourceWithIdentity    = (*sshKeyResource)(nil)
 )
 
 // sshKeyResource is the SSH key resource implementation.
 type sshKeyResource struct {
+	withReadOnlyMode
+
 	client *xelon.Client
 }
 
@@ -67,6 +70,10 @@ The SSH key resource allows you to manage Xelon SSH keys.
 	}
 }
 
+func (r *sshKeyResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, response *resource.IdentitySchemaResponse) {
+	response.IdentitySchema = idIdentitySchema("The ID of the SSH key.")
+}
+
 func (r *sshKeyResource) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
 	if request.ProviderData == nil {
 		return
@@ -85,6 +92,10 @@ func (r *sshKeyResource) Configure(_ context.Context, request resource.Configure
 }
 
 func (r *sshKeyResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	if r.checkReadOnly("create", &response.Diagnostics) {
+		return
+	}
+
 	var data sshKeyResourceModel
 
 	// read plan data into the model
@@ -115,6 +126,7 @@ func (r *sshKeyResource) Create(ctx context.Context, request resource.CreateRequ
 
 	diags = response.State.Set(ctx, &data)
 	response.Diagnostics.Append(diags...)
+	setResourceIdentity(ctx, response.Identity, idResourceIdentityModel{ID: data.ID}, &response.Diagnostics)
 }
 
 func (r *sshKeyResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
@@ -148,9 +160,14 @@ func (r *sshKeyResource) Read(ctx context.Context, request resource.ReadRequest,
 
 	diags = response.State.Set(ctx, &data)
 	response.Diagnostics.Append(diags...)
+	setResourceIdentity(ctx, response.Identity, idResourceIdentityModel{ID: data.ID}, &response.Diagnostics)
 }
 
 func (r *sshKeyResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	if r.checkReadOnly("update", &response.Diagnostics) {
+		return
+	}
+
 	var data sshKeyResourceModel
 
 	// read plan data into the model
@@ -182,9 +199,14 @@ func (r *sshKeyResource) Update(ctx context.Context, request resource.UpdateRequ
 
 	diags = response.State.Set(ctx, &data)
 	response.Diagnostics.Append(diags...)
+	setResourceIdentity(ctx, response.Identity, idResourceIdentityModel{ID: data.ID}, &response.Diagnostics)
 }
 
 func (r *sshKeyResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	if r.checkReadOnly("delete", &response.Diagnostics) {
+		return
+	}
+
 	var data sshKeyResourceModel
 
 	// read state data into the model
@@ -205,5 +227,5 @@ func (r *sshKeyResource) Delete(ctx context.Context, request resource.DeleteRequ
 }
 
 func (r *sshKeyResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), request, response)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), request, response)
 }
@@ -5,13 +5,18 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"net/http"
 	"regexp"
 	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 
 	"github.com/Xelon-AG/xelon-sdk-go/xelon"
 )
@@ -137,6 +142,97 @@ func TestAccResourceXelonSSHKey_expectError(t *testing.T) {
 	})
 }
 
+func TestResourceXelonSSHKey_Lifecycle(t *testing.T) {
+	server := newFakeXelonServer(t)
+	sshKeyPublic := "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIFakeKeyForUnitTestsOnly xelon@unit-test"
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: func(_ *terraform.State) error {
+			var err error
+			server.Mutate(func(s *fakeXelonServer) {
+				if len(s.sshKeys) > 0 {
+					err = fmt.Errorf("%d SSH keys still exist", len(s.sshKeys))
+				}
+			})
+			return err
+		},
+
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + testAccXelonSSHKeyResource("unit-test", sshKeyPublic),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("xelon_ssh_key.foobar", "name", "unit-test"),
+					resource.TestCheckResourceAttr("xelon_ssh_key.foobar", "public_key", sshKeyPublic),
+					resource.TestCheckResourceAttrSet("xelon_ssh_key.foobar", "id"),
+				),
+			},
+			{
+				ResourceName:      "xelon_ssh_key.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: server.ProviderConfig() + testAccXelonSSHKeyResource("unit-test-updated", sshKeyPublic),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("xelon_ssh_key.foobar", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.TestCheckResourceAttr("xelon_ssh_key.foobar", "name", "unit-test-updated"),
+			},
+			{
+				PreConfig: func() {
+					server.InjectError(http.MethodPut, "ssh-keys/1", http.StatusInternalServerError, 1)
+				},
+				Config:      server.ProviderConfig() + testAccXelonSSHKeyResource("unit-test-failed", sshKeyPublic),
+				ExpectError: regexp.MustCompile(`Unable to update SSH key`),
+			},
+			{
+				PreConfig: func() {
+					server.Mutate(func(s *fakeXelonServer) {
+						clear(s.sshKeys)
+					})
+				},
+				Config: server.ProviderConfig() + testAccXelonSSHKeyResource("unit-test-updated", sshKeyPublic),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("xelon_ssh_key.foobar", plancheck.ResourceActionCreate),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestResourceXelonSSHKey_Identity(t *testing.T) {
+	server := newFakeXelonServer(t)
+	sshKeyPublic := "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIFakeKeyForUnitTestsOnly xelon@unit-test"
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + testAccXelonSSHKeyResource("unit-test", sshKeyPublic),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentity("xelon_ssh_key.foobar", map[string]knownvalue.Check{
+						"id": knownvalue.StringExact("1"),
+					}),
+				},
+			},
+			{
+				Config:          server.ProviderConfig() + testAccXelonSSHKeyResource("unit-test", sshKeyPublic),
+				ResourceName:    "xelon_ssh_key.foobar",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+		},
+	})
+}
+
 func testAccCheckSSHKeyDestroy(s *terraform.State) error {
 	ctx := context.Background()
 	client, err := sharedClient("testacc")
@@ -3,7 +3,9 @@ package provider
 import (
 	"context"
 	"net/http"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -20,23 +22,33 @@ var (
 	_ resource.Resource                = (*templateResource)(nil)
 	_ resource.ResourceWithConfigure   = (*templateResource)(nil)
 	_ resource.ResourceWithImportState = (*templateResource)(nil)
+	_ resource.ResourceWithIdentity    = (*templateResource)(nil)
+	_ resourceWithProviderDefaults     = (*templateResource)(nil)
+)
+
+const (
+	defaultTemplateTimeout = 10 * time.Minute
 )
 
 // templateResource is the template resource implementation.
 type templateResource struct {
+	withProviderDefaults
+	withReadOnlyMode
+
 	client *xelon.Client
 }
 
 // templateResourceModel maps the template resource schema data.
 type templateResourceModel struct {
-	Category    types.String `tfsdk:"category"`
-	CloudID     types.String `tfsdk:"cloud_id"`
-	Description types.String `tfsdk:"description"`
-	DeviceID    types.String `tfsdk:"device_id"`
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	TenantID    types.String `tfsdk:"tenant_id"`
-	Type        types.String `tfsdk:"type"`
+	Category    types.String   `tfsdk:"category"`
+	CloudID     types.String   `tfsdk:"cloud_id"`
+	Description types.String   `tfsdk:"description"`
+	DeviceID    types.String   `tfsdk:"device_id"`
+	ID          types.String   `tfsdk:"id"`
+	Name        types.String   `tfsdk:"name"`
+	TenantID    types.String   `tfsdk:"tenant_id"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
+	Type        types.String   `tfsdk:"type"`
 }
 
 func NewTemplateResource() resource.Resource {
@@ -47,7 +59,7 @@ func (r *templateResource) Metadata(_ context.Context, _ resource.MetadataReques
 	response.TypeName = "xelon_template"
 }
 
-func (r *templateResource) Schema(_ context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+func (r *templateResource) Schema(ctx context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
 	response.Schema = schema.Schema{
 		MarkdownDescription: `
 The template resource allows you to manage Xelon custom templates.
@@ -92,9 +104,21 @@ The template resource allows you to manage Xelon custom templates.
 				Required:            true,
 			},
 			"tenant_id": schema.StringAttribute{
-				MarkdownDescription: "The tenant ID of the source device.",
-				Required:            true,
+				MarkdownDescription: "The tenant ID of the source device. Defaults to the provider `default_tenant_id`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					r.tenantIDPlanModifier(true),
+				},
 			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create:            true,
+				CreateDescription: "Applies to each step waiting for the resource. Defaults to 10m.",
+				Update:            true,
+				UpdateDescription: "Defaults to 10m.",
+				Delete:            true,
+				DeleteDescription: "Defaults to 10m.",
+			}),
 			"type": schema.StringAttribute{
 				MarkdownDescription: "The type of the template.",
 				Computed:            true,
@@ -106,6 +130,10 @@ The template resource allows you to manage Xelon custom templates.
 	}
 }
 
+func (r *templateResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, response *resource.IdentitySchemaResponse) {
+	response.IdentitySchema = idIdentitySchema("The ID of the template.")
+}
+
 func (r *templateResource) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
 	if request.ProviderData == nil {
 		return
@@ -124,6 +152,10 @@ func (r *templateResource) Configure(_ context.Context, request resource.Configu
 }
 
 func (r *templateResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	if r.checkReadOnly("create", &response.Diagnostics) {
+		return
+	}
+
 	var data templateResourceModel
 
 	// read plan data into the model
@@ -133,6 +165,13 @@ func (r *templateResource) Create(ctx context.Context, request resource.CreateRe
 		return
 	}
 
+	// configure timeout
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultTemplateTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	createRequest := &xelon.TemplateCreateRequest{
 		DeviceID:  data.DeviceID.ValueString(),
 		Name:      data.Name.ValueString(),
@@ -152,7 +191,7 @@ func (r *templateResource) Create(ctx context.Context, request resource.CreateRe
 
 	templateID := template.ID
 	tflog.Info(ctx, "Waiting for template to be ready", map[string]any{"template_id": templateID})
-	err = helper.WaitTemplateStateReady(ctx, r.client, templateID)
+	err = helper.WaitTemplateStateReady(ctx, r.client, templateID, createTimeout)
 	if err != nil {
 		response.Diagnostics.AddError("Unable to wait for template to be ready", err.Error())
 		return
@@ -177,6 +216,7 @@ func (r *templateResource) Create(ctx context.Context, request resource.CreateRe
 
 	diags = response.State.Set(ctx, &data)
 	response.Diagnostics.Append(diags...)
+	setResourceIdentity(ctx, response.Identity, idResourceIdentityModel{ID: data.ID}, &response.Diagnostics)
 }
 
 func (r *templateResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
@@ -213,9 +253,14 @@ func (r *templateResource) Read(ctx context.Context, request resource.ReadReques
 
 	diags = response.State.Set(ctx, &data)
 	response.Diagnostics.Append(diags...)
+	setResourceIdentity(ctx, response.Identity, idResourceIdentityModel{ID: data.ID}, &response.Diagnostics)
 }
 
 func (r *templateResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	if r.checkReadOnly("update", &response.Diagnostics) {
+		return
+	}
+
 	var data templateResourceModel
 
 	// read plan data into the model
@@ -225,6 +270,15 @@ func (r *templateResource) Update(ctx context.Context, request resource.UpdateRe
 		return
 	}
 
+	// configure timeout
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultTemplateTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	templateID := data.ID.ValueString()
 	updateRequest := &xelon.TemplateUpdateRequest{
 		Description: data.Description.ValueString(),
@@ -248,9 +302,14 @@ func (r *templateResource) Update(ctx context.Context, request resource.UpdateRe
 
 	diags = response.State.Set(ctx, &data)
 	response.Diagnostics.Append(diags...)
+	setResourceIdentity(ctx, response.Identity, idResourceIdentityModel{ID: data.ID}, &response.Diagnostics)
 }
 
 func (r *templateResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	if r.checkReadOnly("delete", &response.Diagnostics) {
+		return
+	}
+
 	var data templateResourceModel
 
 	// read state data into the model
@@ -260,6 +319,15 @@ func (r *templateResource) Delete(ctx context.Context, request resource.DeleteRe
 		return
 	}
 
+	// configure timeout
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultTemplateTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	templateID := data.ID.ValueString()
 	tflog.Debug(ctx, "Deleting template", map[string]any{"template_id": templateID})
 	_, err := r.client.Templates.Delete(ctx, templateID)
@@ -271,5 +339,5 @@ func (r *templateResource) Delete(ctx context.Context, request resource.DeleteRe
 }
 
 func (r *templateResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), request, response)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), request, response)
 }
@@ -6,16 +6,19 @@ import (
 	"net/http"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
@@ -29,11 +32,16 @@ var (
 	_ resource.Resource                = (*tenantUserResource)(nil)
 	_ resource.ResourceWithConfigure   = (*tenantUserResource)(nil)
 	_ resource.ResourceWithImportState = (*tenantUserResource)(nil)
+	_ resource.ResourceWithIdentity    = (*tenantUserResource)(nil)
 	_ resource.ResourceWithModifyPlan  = (*tenantUserResource)(nil)
+	_ resourceWithProviderDefaults     = (*tenantUserResource)(nil)
 )
 
 // tenantUserResource is the tenant user resource implementation.
 type tenantUserResource struct {
+	withProviderDefaults
+	withReadOnlyMode
+
 	client *xelon.Client
 }
 
@@ -44,6 +52,8 @@ type tenantUserResourceModel struct {
 	ID                    types.String `tfsdk:"id"`
 	LastName              types.String `tfsdk:"last_name"`
 	Password              types.String `tfsdk:"password"`
+	PasswordWO            types.String `tfsdk:"password_wo"`
+	PasswordWOVersion     types.Int64  `tfsdk:"password_wo_version"`
 	Permissions           types.Set    `tfsdk:"permissions"` // []types.String
 	RequirePasswordChange types.Bool   `tfsdk:"require_password_change"`
 	Roles                 types.Set    `tfsdk:"roles"` // []types.String
@@ -51,6 +61,12 @@ type tenantUserResourceModel struct {
 	TenantID              types.String `tfsdk:"tenant_id"`
 }
 
+// tenantUserResourceIdentityModel maps the tenant user resource identity schema data.
+type tenantUserResourceIdentityModel struct {
+	ID       types.String `tfsdk:"id"`
+	TenantID types.String `tfsdk:"tenant_id"`
+}
+
 func NewTenantUserResource() resource.Resource {
 	return &tenantUserResource{}
 }
@@ -98,11 +114,30 @@ The tenant user resource allows you to manage a user that belongs to a Xelon ten
 				},
 			},
 			"password": schema.StringAttribute{
-				MarkdownDescription: "Password for the tenant user.",
-				Required:            true,
+				MarkdownDescription: "Password for the tenant user. Exactly one of `password` or `password_wo` must be set.",
+				Optional:            true,
 				Sensitive:           true,
 				Validators: []validator.String{
 					stringvalidator.LengthAtLeast(1),
+					stringvalidator.ExactlyOneOf(path.MatchRoot("password_wo")),
+				},
+			},
+			"password_wo": schema.StringAttribute{
+				MarkdownDescription: "Password for the tenant user, which is not stored in the plan or state. " +
+					"Requires Terraform 1.11 or later. Increment `password_wo_version` to update the password.",
+				Optional:  true,
+				Sensitive: true,
+				WriteOnly: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.AlsoRequires(path.MatchRoot("password_wo_version")),
+				},
+			},
+			"password_wo_version": schema.Int64Attribute{
+				MarkdownDescription: "The version of `password_wo`. Changing this value updates the password of the tenant user.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("password_wo")),
 				},
 			},
 			"permissions": schema.SetAttribute{
@@ -136,9 +171,12 @@ The tenant user resource allows you to manage a user that belongs to a Xelon ten
 				Default:  booldefault.StaticBool(false),
 			},
 			"tenant_id": schema.StringAttribute{
-				MarkdownDescription: "The ID of the tenant that owns the user. Changing this value requires replacing the user.",
-				Required:            true,
+				MarkdownDescription: "The ID of the tenant that owns the user. Changing this value requires replacing the user. " +
+					"Defaults to the provider `default_tenant_id`.",
+				Optional: true,
+				Computed: true,
 				PlanModifiers: []planmodifier.String{
+					r.tenantIDPlanModifier(true),
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{
@@ -149,6 +187,21 @@ The tenant user resource allows you to manage a user that belongs to a Xelon ten
 	}
 }
 
+func (r *tenantUserResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, response *resource.IdentitySchemaResponse) {
+	response.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "ID of the tenant user.",
+				RequiredForImport: true,
+			},
+			"tenant_id": identityschema.StringAttribute{
+				Description:       "The ID of the tenant that owns the user.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *tenantUserResource) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
 	if request.ProviderData == nil {
 		return
@@ -167,6 +220,10 @@ func (r *tenantUserResource) Configure(_ context.Context, request resource.Confi
 }
 
 func (r *tenantUserResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	if r.checkReadOnly("create", &response.Diagnostics) {
+		return
+	}
+
 	var data tenantUserResourceModel
 
 	diags := request.Plan.Get(ctx, &data)
@@ -185,12 +242,18 @@ func (r *tenantUserResource) Create(ctx context.Context, request resource.Create
 		return
 	}
 
+	password, diags := tenantUserPassword(ctx, request.Config, data.Password)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	createRequest := &xelon.TenantUserCreateRequest{
 		Email:                 email,
 		FirstName:             data.FirstName.ValueString(),
 		LastName:              data.LastName.ValueString(),
-		Password:              data.Password.ValueString(),
-		PasswordConfirmation:  data.Password.ValueString(),
+		Password:              password,
+		PasswordConfirmation:  password,
 		Permissions:           permissions,
 		RequirePasswordChange: data.RequirePasswordChange.ValueBool(),
 		Roles:                 roles,
@@ -251,6 +314,7 @@ func (r *tenantUserResource) Create(ctx context.Context, request resource.Create
 
 	diags = response.State.Set(ctx, &data)
 	response.Diagnostics.Append(diags...)
+	setResourceIdentity(ctx, response.Identity, tenantUserResourceIdentityModel{ID: data.ID, TenantID: data.TenantID}, &response.Diagnostics)
 }
 
 func (r *tenantUserResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
@@ -303,9 +367,14 @@ func (r *tenantUserResource) Read(ctx context.Context, request resource.ReadRequ
 
 	diags = response.State.Set(ctx, &data)
 	response.Diagnostics.Append(diags...)
+	setResourceIdentity(ctx, response.Identity, tenantUserResourceIdentityModel{ID: data.ID, TenantID: data.TenantID}, &response.Diagnostics)
 }
 
 func (r *tenantUserResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	if r.checkReadOnly("update", &response.Diagnostics) {
+		return
+	}
+
 	var plan tenantUserResourceModel
 	var state tenantUserResourceModel
 
@@ -324,7 +393,7 @@ func (r *tenantUserResource) Update(ctx context.Context, request resource.Update
 	tenantID := plan.TenantID.ValueString()
 	userID := state.ID.ValueString()
 	profileChanged := !plan.FirstName.Equal(state.FirstName) || !plan.LastName.Equal(state.LastName)
-	passwordChanged := !plan.Password.Equal(state.Password)
+	passwordChanged := !plan.Password.Equal(state.Password) || !plan.PasswordWOVersion.Equal(state.PasswordWOVersion)
 	accessChanged := !plan.Roles.Equal(state.Roles) || !plan.Permissions.Equal(state.Permissions)
 
 	tflog.Debug(ctx, "updating tenant user", map[string]any{
@@ -355,9 +424,14 @@ func (r *tenantUserResource) Update(ctx context.Context, request resource.Update
 	}
 
 	if passwordChanged {
+		password, diags := tenantUserPassword(ctx, request.Config, plan.Password)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
 		updateRequest := &xelon.TenantUserPasswordUpdateRequest{
-			Password:             plan.Password.ValueString(),
-			PasswordConfirmation: plan.Password.ValueString(),
+			Password:             password,
+			PasswordConfirmation: password,
 		}
 
 		tflog.Trace(ctx, "updating tenant user password via API", map[string]any{
@@ -437,9 +511,14 @@ func (r *tenantUserResource) Update(ctx context.Context, request resource.Update
 
 	diags = response.State.Set(ctx, &plan)
 	response.Diagnostics.Append(diags...)
+	setResourceIdentity(ctx, response.Identity, tenantUserResourceIdentityModel{ID: plan.ID, TenantID: plan.TenantID}, &response.Diagnostics)
 }
 
 func (r *tenantUserResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	if r.checkReadOnly("delete", &response.Diagnostics) {
+		return
+	}
+
 	var data tenantUserResourceModel
 
 	diags := request.State.Get(ctx, &data)
@@ -479,15 +558,38 @@ func (r *tenantUserResource) Delete(ctx context.Context, request resource.Delete
 	})
 }
 
+// tenantUserPassword returns the configured password, or the write-only
+// password_wo, which is only available in the config.
+func tenantUserPassword(ctx context.Context, config tfsdk.Config, password types.String) (string, diag.Diagnostics) {
+	if !password.IsNull() {
+		return password.ValueString(), nil
+	}
+
+	var passwordWO types.String
+	diags := config.GetAttribute(ctx, path.Root("password_wo"), &passwordWO)
+
+	return passwordWO.ValueString(), diags
+}
+
 func (r *tenantUserResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
-	tenantID, userID, err := parseTenantUserImportID(request.ID)
-	if err != nil {
-		response.Diagnostics.AddError("Invalid import identifier", "Expected format: <tenant-id>/<user-id>")
-		return
+	var identity tenantUserResourceIdentityModel
+	if request.ID != "" {
+		tenantID, userID, err := parseTenantUserImportID(request.ID)
+		if err != nil {
+			response.Diagnostics.AddError("Invalid import identifier", "Expected format: <tenant-id>/<user-id>")
+			return
+		}
+		identity = tenantUserResourceIdentityModel{ID: types.StringValue(userID), TenantID: types.StringValue(tenantID)}
+	} else {
+		response.Diagnostics.Append(request.Identity.Get(ctx, &identity)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
 	}
 
-	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("tenant_id"), tenantID)...)
-	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("id"), userID)...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("tenant_id"), identity.TenantID)...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("id"), identity.ID)...)
+	setResourceIdentity(ctx, response.Identity, identity, &response.Diagnostics)
 }
 
 func (r *tenantUserResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
@@ -38,13 +38,19 @@ func TestResourceXelonTenantUser_Schema_RolesAndPermissions(t *testing.T) {
 func TestResourceXelonTenantUser_Schema_ReplacementAndMutableAttributes(t *testing.T) {
 	userSchema := testTenantUserResourceSchema(t)
 
-	for _, attributeName := range []string{"email", "tenant_id"} {
-		t.Run(attributeName+" requires replacement", func(t *testing.T) {
-			attribute, ok := userSchema.Attributes[attributeName].(schema.StringAttribute)
-			require.True(t, ok)
-			require.Len(t, attribute.PlanModifiers, 1)
-		})
-	}
+	t.Run("email requires replacement", func(t *testing.T) {
+		attribute, ok := userSchema.Attributes["email"].(schema.StringAttribute)
+		require.True(t, ok)
+		require.Len(t, attribute.PlanModifiers, 1)
+	})
+
+	t.Run("tenant_id defaults to provider and requires replacement", func(t *testing.T) {
+		attribute, ok := userSchema.Attributes["tenant_id"].(schema.StringAttribute)
+		require.True(t, ok)
+		require.Len(t, attribute.PlanModifiers, 2)
+		assert.Contains(t, attribute.PlanModifiers[0].Description(context.Background()), "default_tenant_id")
+		assert.Contains(t, attribute.PlanModifiers[1].Description(context.Background()), "destroy and recreate")
+	})
 
 	for _, attributeName := range []string{"first_name", "last_name", "password"} {
 		t.Run(attributeName+" is mutable", func(t *testing.T) {
@@ -84,6 +90,24 @@ func TestResourceXelonTenantUser_Schema_CreateTimeBooleans(t *testing.T) {
 	}
 }
 
+func TestResourceXelonTenantUser_tenantUserPassword(t *testing.T) {
+	ctx := context.Background()
+	userSchema := testTenantUserResourceSchema(t)
+	model := testTenantUserModel(t, tenantUserModelOptions{})
+	model.Password = types.StringNull()
+	model.PasswordWO = types.StringValue("write-only-password")
+	model.PasswordWOVersion = types.Int64Value(1)
+	config := tfsdk.Config{Schema: userSchema, Raw: testTenantUserResourcePlan(t, ctx, userSchema, model).Raw}
+
+	password, diags := tenantUserPassword(ctx, config, types.StringValue("SecurePass123!"))
+	require.False(t, diags.HasError())
+	assert.Equal(t, "SecurePass123!", password)
+
+	password, diags = tenantUserPassword(ctx, config, types.StringNull())
+	require.False(t, diags.HasError())
+	assert.Equal(t, "write-only-password", password)
+}
+
 func TestResourceXelonTenantUser_Model_FromAPI_PreservesPassword(t *testing.T) {
 	ctx := context.Background()
 	user := &xelon.TenantUserWithDetails{
@@ -276,6 +300,50 @@ func TestResourceXelonTenantUser_ImportState_InvalidIDDiagnostic(t *testing.T) {
 	assert.True(t, response.Diagnostics.Equal(expectedTenantUserInvalidImportIDDiagnostics()))
 }
 
+func TestResourceXelonTenantUser_ImportState_Identity(t *testing.T) {
+	ctx := context.Background()
+	r := NewTenantUserResource().(*tenantUserResource)
+	userSchema := testTenantUserResourceSchema(t)
+
+	identitySchemaResponse := &resource.IdentitySchemaResponse{}
+	r.IdentitySchema(ctx, resource.IdentitySchemaRequest{}, identitySchemaResponse)
+	require.False(t, identitySchemaResponse.Diagnostics.HasError())
+	identitySchema := identitySchemaResponse.IdentitySchema
+	identityType := identitySchema.Type().TerraformType(ctx)
+
+	response := &resource.ImportStateResponse{
+		State: tfsdk.State{
+			Schema: userSchema,
+			Raw:    tftypes.NewValue(userSchema.Type().TerraformType(ctx), nil),
+		},
+		Identity: &tfsdk.ResourceIdentity{
+			Schema: identitySchema,
+			Raw:    tftypes.NewValue(identityType, nil),
+		},
+	}
+
+	r.ImportState(ctx, resource.ImportStateRequest{
+		Identity: &tfsdk.ResourceIdentity{
+			Schema: identitySchema,
+			Raw: tftypes.NewValue(identityType, map[string]tftypes.Value{
+				"id":        tftypes.NewValue(tftypes.String, "user-456"),
+				"tenant_id": tftypes.NewValue(tftypes.String, "tenant-123"),
+			}),
+		},
+	}, response)
+	require.False(t, response.Diagnostics.HasError())
+
+	var data tenantUserResourceModel
+	require.False(t, response.State.Get(ctx, &data).HasError())
+	assert.Equal(t, "user-456", data.ID.ValueString())
+	assert.Equal(t, "tenant-123", data.TenantID.ValueString())
+
+	var identity tenantUserResourceIdentityModel
+	require.False(t, response.Identity.Get(ctx, &identity).HasError())
+	assert.Equal(t, "user-456", identity.ID.ValueString())
+	assert.Equal(t, "tenant-123", identity.TenantID.ValueString())
+}
+
 func testTenantUserResourceSchema(t *testing.T) schema.Schema {
 	t.Helper()
 
@@ -0,0 +1,1306 @@
+package provider
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/Xelon-AG/xelon-sdk-go/xelon"
+)
+
+// fakeXelonServer is a stateful in-memory fake of the Xelon HQ API (/api/v2/)
+// used to run resource lifecycle tests without real credentials. Only the
+// endpoints called by the provider are served, everything else returns 404.
+type fakeXelonServer struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	mux      *http.ServeMux
+	lastID   int
+	failures []*fakeXelonFailure
+
+	backupPlans             []xelon.BackupPlan
+	deviceBackupPlans       map[string]int
+	deviceNetworks          map[string][]xelon.DeviceNetwork
+	deviceStarts            map[string]int
+	devices                 map[string]*xelon.Device
+	dnsRecords              map[string][]xelon.DNSRecord
+	dnsSOAs                 map[string]*xelon.DNSSOA
+	dnsZones                map[string]*xelon.DNSZone
+	firewalls               map[string]*xelon.Firewall
+	kubernetesClusters      map[string]*xelon.KubernetesCluster
+	kubernetesControlPlanes map[string]*xelon.KubernetesClusterControlPlane
+	kubernetesLoadBalancers map[string]*xelon.KubernetesClusterLoadBalancer
+	kubernetesNodePools     map[string][]xelon.KubernetesClusterNodePool
+	kubernetesVersions      map[string][]string
+	loadBalancers           map[string]*xelon.LoadBalancer
+	networks                map[string]*xelon.Network
+	objectStorageBuckets    map[string]*xelon.ObjectStorageBucket
+	objectStorageUsers      map[string]*xelon.ObjectStorageUser
+	sshKeys                 map[string]*xelon.SSHKey
+	tenant                  *xelon.Tenant
+	tenantUsers             map[string]*xelon.TenantUserWithDetails
+}
+
+// fakeXelonFailure is an injected error response returned instead of the
+// regular handler for the given method and path.
+type fakeXelonFailure struct {
+	method      string
+	path        string
+	statusCode  int
+	validations map[string]any
+	remaining   int
+}
+
+// newFakeXelonServer starts a new fake Xelon HQ API server, which is closed
+// automatically at the end of the test.
+func newFakeXelonServer(t *testing.T) *fakeXelonServer {
+	t.Helper()
+
+	s := &fakeXelonServer{
+		mux:                     http.NewServeMux(),
+		backupPlans:             []xelon.BackupPlan{{ID: 1, Name: "Daily"}, {ID: 2, Name: "Weekly"}},
+		deviceBackupPlans:       make(map[string]int),
+		deviceNetworks:          make(map[string][]xelon.DeviceNetwork),
+		deviceStarts:            make(map[string]int),
+		devices:                 make(map[string]*xelon.Device),
+		dnsRecords:              make(map[string][]xelon.DNSRecord),
+		dnsSOAs:                 make(map[string]*xelon.DNSSOA),
+		dnsZones:                make(map[string]*xelon.DNSZone),
+		firewalls:               make(map[string]*xelon.Firewall),
+		kubernetesClusters:      make(map[string]*xelon.KubernetesCluster),
+		kubernetesControlPlanes: make(map[string]*xelon.KubernetesClusterControlPlane),
+		kubernetesLoadBalancers: make(map[string]*xelon.KubernetesClusterLoadBalancer),
+		kubernetesNodePools:     make(map[string][]xelon.KubernetesClusterNodePool),
+		kubernetesVersions:      map[string][]string{"v1.10.3": {"v1.31.4"}, "v1.11.1": {"v1.31.4", "v1.32.0"}},
+		loadBalancers:           make(map[string]*xelon.LoadBalancer),
+		networks:                make(map[string]*xelon.Network),
+		objectStorageBuckets:    make(map[string]*xelon.ObjectStorageBucket),
+		objectStorageUsers:      make(map[string]*xelon.ObjectStorageUser),
+		sshKeys:                 make(map[string]*xelon.SSHKey),
+		tenant:                  &xelon.Tenant{ID: "tenant-123", Name: "Fake Tenant", Status: "active", Type: "customer"},
+		tenantUsers:             make(map[string]*xelon.TenantUserWithDetails),
+	}
+	s.registerBackupRoutes()
+	s.registerDeviceRoutes()
+	s.registerDNSRoutes()
+	s.registerFirewallRoutes()
+	s.registerKubernetesRoutes()
+	s.registerLoadBalancerRoutes()
+	s.registerNetworkRoutes()
+	s.registerObjectStorageRoutes()
+	s.registerSSHKeyRoutes()
+	s.registerTenantRoutes()
+
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+// BaseURL returns the API base URL to be used as provider base_url.
+func (s *fakeXelonServer) BaseURL() string {
+	return s.URL + "/api/v2/"
+}
+
+// ProviderConfig returns a provider block pointing to the fake server.
+func (s *fakeXelonServer) ProviderConfig() string {
+	return fmt.Sprintf(`
+provider "xelon" {
+  base_url  = %[1]q
+  client_id = "fake-client-id"
+  token     = "fake-token"
+}
+`, s.BaseURL())
+}
+
+// InjectError makes the next times requests matching method and path (relative
+// to the base URL, e.g. "dns/zones/1") fail with the given status code.
+func (s *fakeXelonServer) InjectError(method, path string, statusCode, times int) {
+	s.InjectValidationError(method, path, statusCode, times, nil)
+}
+
+// InjectValidationError is like InjectError, but additionally includes
+// validation messages into the error response body.
+func (s *fakeXelonServer) InjectValidationError(method, path string, statusCode, times int, validations map[string]any) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.failures = append(s.failures, &fakeXelonFailure{
+		method:      method,
+		path:        "/api/v2/" + path,
+		statusCode:  statusCode,
+		validations: validations,
+		remaining:   times,
+	})
+}
+
+// Mutate runs fn while holding the server lock, it can be used to change the
+// stored objects out-of-band (e.g. to simulate drift or external deletion).
+func (s *fakeXelonServer) Mutate(fn func(s *fakeXelonServer)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	fn(s)
+}
+
+func (s *fakeXelonServer) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	for _, failure := range s.failures {
+		if failure.remaining > 0 && failure.method == r.Method && failure.path == r.URL.Path {
+			failure.remaining--
+			s.mu.Unlock()
+			writeFakeXelonError(w, failure.statusCode, failure.validations)
+			return
+		}
+	}
+	s.mu.Unlock()
+
+	s.mux.ServeHTTP(w, r)
+}
+
+// handle registers handler for pattern (relative to /api/v2/), all handlers
+// are serialized by the server lock.
+func (s *fakeXelonServer) handle(pattern string, handler http.HandlerFunc) {
+	method, path, _ := strings.Cut(pattern, " ")
+	s.mux.HandleFunc(method+" /api/v2/"+path, func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		handler(w, r)
+	})
+}
+
+func (s *fakeXelonServer) nextID() string {
+	s.lastID++
+	return strconv.Itoa(s.lastID)
+}
+
+func (s *fakeXelonServer) registerBackupRoutes() {
+	s.handle("GET backups/plans", func(w http.ResponseWriter, _ *http.Request) {
+		writeFakeXelonList(w, s.backupPlans)
+	})
+	s.handle("GET devices/{deviceID}/backup-plan", func(w http.ResponseWriter, r *http.Request) {
+		planID, ok := s.deviceBackupPlans[r.PathValue("deviceID")]
+		if !ok {
+			writeFakeXelonError(w, http.StatusNotFound, nil)
+			return
+		}
+		for _, plan := range s.backupPlans {
+			if plan.ID == planID {
+				writeFakeXelonJSON(w, http.StatusOK, plan)
+				return
+			}
+		}
+		writeFakeXelonError(w, http.StatusNotFound, nil)
+	})
+	s.handle("POST devices/{deviceID}/backup-plan/{planID}", func(w http.ResponseWriter, r *http.Request) {
+		planID, err := strconv.Atoi(r.PathValue("planID"))
+		if err != nil {
+			writeFakeXelonError(w, http.StatusBadRequest, nil)
+			return
+		}
+		s.deviceBackupPlans[r.PathValue("deviceID")] = planID
+		w.WriteHeader(http.StatusNoContent)
+	})
+	s.handle("DELETE devices/{deviceID}/backup-plan", func(w http.ResponseWriter, r *http.Request) {
+		delete(s.deviceBackupPlans, r.PathValue("deviceID"))
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+func (s *fakeXelonServer) registerDeviceRoutes() {
+	s.handle("GET devices", func(w http.ResponseWriter, r *http.Request) {
+		search := r.URL.Query().Get("search")
+		devices := slices.DeleteFunc(fakeXelonValues(s.devices), func(device xelon.Device) bool {
+			return !strings.Contains(device.DisplayName, search)
+		})
+		writeFakeXelonList(w, devices)
+	})
+	s.handle("POST devices", func(w http.ResponseWriter, r *http.Request) {
+		var createRequest xelon.DeviceCreateRequest
+		if !decodeFakeXelonRequest(w, r, &createRequest) {
+			return
+		}
+		device := &xelon.Device{
+			CPUCores:              createRequest.CPUCores,
+			CPUCoresHotAddEnabled: createRequest.EnableCPUCoresHotAdd,
+			DisplayName:           createRequest.DisplayName,
+			HostName:              createRequest.HostName,
+			ID:                    s.nextID(),
+			PoweredOn:             true,
+			RAM:                   createRequest.RAM,
+			RAMHotAddEnabled:      createRequest.EnableRAMHotAdd,
+			State:                 1,
+			Storages:              []xelon.DeviceStorage{{ID: s.nextID(), Size: createRequest.DiskSize}},
+			Tenant:                &xelon.Tenant{ID: createRequest.TenantID},
+		}
+		if createRequest.SwapDiskSize > 0 {
+			device.Storages = append(device.Storages, xelon.DeviceStorage{
+				ID:         s.nextID(),
+				Size:       createRequest.SwapDiskSize,
+				UnitNumber: 1,
+			})
+		}
+		var networks []xelon.DeviceNetwork
+		for _, network := range createRequest.Networks {
+			networks = append(networks, xelon.DeviceNetwork{Connected: network.Connected, ID: network.NetworkID})
+		}
+		s.devices[device.ID] = device
+		s.deviceNetworks[device.ID] = networks
+		writeFakeXelonJSON(w, http.StatusCreated, device)
+	})
+	s.handle("GET devices/{deviceID}", func(w http.ResponseWriter, r *http.Request) {
+		writeFakeXelonObject(w, s.devices, r.PathValue("deviceID"))
+	})
+	s.handle("PATCH devices/{deviceID}", func(w http.ResponseWriter, r *http.Request) {
+		device, ok := s.devices[r.PathValue("deviceID")]
+		if !ok {
+			writeFakeXelonError(w, http.StatusNotFound, nil)
+			return
+		}
+		var updateRequest xelon.DeviceUpdateRequest
+		if !decodeFakeXelonRequest(w, r, &updateRequest) {
+			return
+		}
+		device.DisplayName = updateRequest.DisplayName
+		writeFakeXelonJSON(w, http.StatusOK, device)
+	})
+	s.handle("PATCH devices/{deviceID}/hardware", func(w http.ResponseWriter, r *http.Request) {
+		device, ok := s.devices[r.PathValue("deviceID")]
+		if !ok {
+			writeFakeXelonError(w, http.StatusNotFound, nil)
+			return
+		}
+		var updateRequest xelon.DeviceUpdateHardwareRequest
+		if !decodeFakeXelonRequest(w, r, &updateRequest) {
+			return
+		}
+		device.CPUCores = updateRequest.CPUCores
+		device.RAM = updateRequest.RAM
+		writeFakeXelonJSON(w, http.StatusOK, device)
+	})
+	s.handle("PATCH devices/{deviceID}/disk", func(w http.ResponseWriter, r *http.Request) {
+		device, ok := s.devices[r.PathValue("deviceID")]
+		if !ok {
+			writeFakeXelonError(w, http.StatusNotFound, nil)
+			return
+		}
+		var updateRequest xelon.DeviceUpdateDiskRequest
+		if !decodeFakeXelonRequest(w, r, &updateRequest) {
+			return
+		}
+		for i := range device.Storages {
+			if device.Storages[i].ID == updateRequest.DiskID {
+				device.Storages[i].Size = updateRequest.Size
+			}
+		}
+		writeFakeXelonJSON(w, http.StatusOK, device)
+	})
+	s.handle("DELETE devices/{deviceID}", func(w http.ResponseWriter, r *http.Request) {
+		deleteFakeXelonObject(w, s.devices, r.PathValue("deviceID"))
+		delete(s.deviceNetworks, r.PathValue("deviceID"))
+	})
+	s.handle("POST devices/{deviceID}/start", func(w http.ResponseWriter, r *http.Request) {
+		s.setDevicePowerState(w, r.PathValue("deviceID"), true)
+	})
+	s.handle("POST devices/{deviceID}/stop", func(w http.ResponseWriter, r *http.Request) {
+		s.setDevicePowerState(w, r.PathValue("deviceID"), false)
+	})
+	s.handle("GET devices/{deviceID}/network-info", func(w http.ResponseWriter, r *http.Request) {
+		if _, ok := s.devices[r.PathValue("deviceID")]; !ok {
+			writeFakeXelonError(w, http.StatusNotFound, nil)
+			return
+		}
+		writeFakeXelonList(w, s.deviceNetworks[r.PathValue("deviceID")])
+	})
+	s.handle("GET devices/{deviceID}/snapshots", func(w http.ResponseWriter, _ *http.Request) {
+		writeFakeXelonList(w, []xelon.Snapshot{})
+	})
+	// templates, ISOs and persistent storages are only listed, e.g. by the export
+	s.handle("GET templates", func(w http.ResponseWriter, _ *http.Request) {
+		writeFakeXelonList(w, []xelon.Template{})
+	})
+	s.handle("GET isos", func(w http.ResponseWriter, _ *http.Request) {
+		writeFakeXelonList(w, []xelon.ISO{})
+	})
+	s.handle("GET persistent-storages", func(w http.ResponseWriter, _ *http.Request) {
+		writeFakeXelonList(w, []xelon.PersistentStorage{})
+	})
+}
+
+func (s *fakeXelonServer) setDevicePowerState(w http.ResponseWriter, deviceID string, poweredOn bool) {
+	device, ok := s.devices[deviceID]
+	if !ok {
+		writeFakeXelonError(w, http.StatusNotFound, nil)
+		return
+	}
+	if poweredOn && !device.PoweredOn {
+		s.deviceStarts[deviceID]++
+	}
+	device.PoweredOn = poweredOn
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *fakeXelonServer) registerDNSRoutes() {
+	s.handle("GET dns/zones", func(w http.ResponseWriter, _ *http.Request) {
+		writeFakeXelonList(w, fakeXelonValues(s.dnsZones))
+	})
+	s.handle("POST dns/zones", func(w http.ResponseWriter, r *http.Request) {
+		var createRequest xelon.DNSZoneCreateRequest
+		if !decodeFakeXelonRequest(w, r, &createRequest) {
+			return
+		}
+		for _, dnsZone := range s.dnsZones {
+			if dnsZone.Name == createRequest.Domain {
+				writeFakeXelonError(w, http.StatusUnprocessableEntity, map[string]any{
+					"domain": []any{"The domain has already been taken."},
+				})
+				return
+			}
+		}
+		dnsZone := &xelon.DNSZone{ID: s.nextID(), Name: createRequest.Domain}
+		s.dnsZones[dnsZone.ID] = dnsZone
+		s.dnsSOAs[dnsZone.ID] = &xelon.DNSSOA{
+			AdminEmail:   "hostmaster@" + createRequest.Domain,
+			Expire:       1209600,
+			PrimaryNS:    "ns1.xelon.ch",
+			Refresh:      86400,
+			Retry:        7200,
+			SerialNumber: 1,
+			TTL:          3600,
+		}
+		writeFakeXelonJSON(w, http.StatusCreated, dnsZone)
+	})
+	s.handle("GET dns/zones/{zoneID}", func(w http.ResponseWriter, r *http.Request) {
+		writeFakeXelonObject(w, s.dnsZones, r.PathValue("zoneID"))
+	})
+	s.handle("DELETE dns/zones/{zoneID}", func(w http.ResponseWriter, r *http.Request) {
+		deleteFakeXelonObject(w, s.dnsZones, r.PathValue("zoneID"))
+		delete(s.dnsRecords, r.PathValue("zoneID"))
+		delete(s.dnsSOAs, r.PathValue("zoneID"))
+	})
+	s.handle("GET dns/zones/{zoneID}/records", func(w http.ResponseWriter, r *http.Request) {
+		if _, ok := s.dnsZones[r.PathValue("zoneID")]; !ok {
+			writeFakeXelonError(w, http.StatusNotFound, nil)
+			return
+		}
+		writeFakeXelonList(w, s.dnsRecords[r.PathValue("zoneID")])
+	})
+	s.handle("POST dns/zones/{zoneID}/records", func(w http.ResponseWriter, r *http.Request) {
+		zoneID := r.PathValue("zoneID")
+		if _, ok := s.dnsZones[zoneID]; !ok {
+			writeFakeXelonError(w, http.StatusNotFound, nil)
+			return
+		}
+		var createRequest xelon.DNSRecordCreateRequest
+		if !decodeFakeXelonRequest(w, r, &createRequest) {
+			return
+		}
+		s.lastID++
+		s.dnsRecords[zoneID] = append(s.dnsRecords[zoneID], xelon.DNSRecord{
+			Host:   createRequest.Host,
+			ID:     s.lastID,
+			Record: createRequest.Record,
+			TTL:    createRequest.TTL,
+			Type:   createRequest.Type,
+		})
+		w.WriteHeader(http.StatusCreated)
+	})
+	s.handle("PUT dns/zones/{zoneID}/records/{recordID}", func(w http.ResponseWriter, r *http.Request) {
+		record := s.findDNSRecord(r.PathValue("zoneID"), r.PathValue("recordID"))
+		if record == nil {
+			writeFakeXelonError(w, http.StatusNotFound, nil)
+			return
+		}
+		var updateRequest xelon.DNSRecordUpdateRequest
+		if !decodeFakeXelonRequest(w, r, &updateRequest) {
+			return
+		}
+		record.Host = updateRequest.Host
+		record.Record = updateRequest.Record
+		record.TTL = updateRequest.TTL
+		record.Type = updateRequest.Type
+		w.WriteHeader(http.StatusNoContent)
+	})
+	s.handle("DELETE dns/zones/{zoneID}/records/{recordID}", func(w http.ResponseWriter, r *http.Request) {
+		zoneID := r.PathValue("zoneID")
+		recordID, _ := strconv.Atoi(r.PathValue("recordID"))
+		records := s.dnsRecords[zoneID]
+		index := slices.IndexFunc(records, func(record xelon.DNSRecord) bool { return record.ID == recordID })
+		if index < 0 {
+			writeFakeXelonError(w, http.StatusNotFound, nil)
+			return
+		}
+		s.dnsRecords[zoneID] = slices.Delete(records, index, index+1)
+		w.WriteHeader(http.StatusNoContent)
+	})
+	s.handle("GET dns/zones/{zoneID}/soa", func(w http.ResponseWriter, r *http.Request) {
+		writeFakeXelonObject(w, s.dnsSOAs, r.PathValue("zoneID"))
+	})
+	s.handle("PUT dns/zones/{zoneID}/soa", func(w http.ResponseWriter, r *http.Request) {
+		soa, ok := s.dnsSOAs[r.PathValue("zoneID")]
+		if !ok {
+			writeFakeXelonError(w, http.StatusNotFound, nil)
+			return
+		}
+		var updateRequest xelon.DNSSOAUpdateRequest
+		if !decodeFakeXelonRequest(w, r, &updateRequest) {
+			return
+		}
+		soa.AdminEmail = updateRequest.AdminEmail
+		soa.Expire = updateRequest.Expire
+		soa.PrimaryNS = updateRequest.PrimaryNS
+		soa.Refresh = updateRequest.Refresh
+		soa.Retry = updateRequest.Retry
+		soa.SerialNumber++
+		soa.TTL = updateRequest.TTL
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+func (s *fakeXelonServer) findDNSRecord(zoneID, recordID string) *xelon.DNSRecord {
+	id, err := strconv.Atoi(recordID)
+	if err != nil {
+		return nil
+	}
+	records := s.dnsRecords[zoneID]
+	for i := range records {
+		if records[i].ID == id {
+			return &records[i]
+		}
+	}
+	return nil
+}
+
+func (s *fakeXelonServer) registerFirewallRoutes() {
+	s.handle("GET firewalls", func(w http.ResponseWriter, _ *http.Request) {
+		writeFakeXelonList(w, fakeXelonValues(s.firewalls))
+	})
+	s.handle("POST firewalls", func(w http.ResponseWriter, r *http.Request) {
+		var createRequest xelon.FirewallCreateRequest
+		if !decodeFakeXelonRequest(w, r, &createRequest) {
+			return
+		}
+		firewall := &xelon.Firewall{
+			Cloud:             &xelon.Cloud{ID: createRequest.CloudID},
+			ExternalIPAddress: "198.51.100.10",
+			HealthStatus:      "healthy",
+			ID:                s.nextID(),
+			InternalIPAddress: createRequest.InternalIPAddress,
+			Name:              createRequest.Name,
+			State:             1,
+			Tenant:            &xelon.Tenant{ID: createRequest.TenantID},
+		}
+		s.firewalls[firewall.ID] = firewall
+		writeFakeXelonJSON(w, http.StatusCreated, firewall)
+	})
+	s.handle("GET firewalls/{firewallID}", func(w http.ResponseWriter, r *http.Request) {
+		writeFakeXelonObject(w, s.firewalls, r.PathValue("firewallID"))
+	})
+	s.handle("PATCH firewalls/{firewallID}", func(w http.ResponseWriter, r *http.Request) {
+		firewall, ok := s.firewalls[r.PathValue("firewallID")]
+		if !ok {
+			writeFakeXelonError(w, http.StatusNotFound, nil)
+			return
+		}
+		var updateRequest xelon.FirewallUpdateRequest
+		if !decodeFakeXelonRequest(w, r, &updateRequest) {
+			return
+		}
+		firewall.Name = updateRequest.Name
+		writeFakeXelonJSON(w, http.StatusOK, firewall)
+	})
+	s.handle("DELETE firewalls/{firewallID}", func(w http.ResponseWriter, r *http.Request) {
+		deleteFakeXelonObject(w, s.firewalls, r.PathValue("firewallID"))
+	})
+	s.handle("POST firewalls/{firewallID}/forwarding-rules", func(w http.ResponseWriter, r *http.Request) {
+		firewall, ok := s.firewalls[r.PathValue("firewallID")]
+		if !ok {
+			writeFakeXelonError(w, http.StatusNotFound, nil)
+			return
+		}
+		var createRequest xelon.FirewallCreateForwardingRuleRequest
+		if !decodeFakeXelonRequest(w, r, &createRequest) {
+			return
+		}
+		rule := xelon.FirewallForwardingRule{
+			DestinationIPAddresses: createRequest.DestinationIPAddresses,
+			ExternalPort:           createRequest.ExternalPort,
+			ID:                     s.nextID(),
+			InternalPort:           createRequest.InternalPort,
+			Protocol:               createRequest.Protocol,
+			SourceIPAddresses:      createRequest.SourceIPAddresses,
+			Type:                   createRequest.Type,
+		}
+		firewall.ForwardingRules = append(firewall.ForwardingRules, rule)
+		writeFakeXelonJSON(w, http.StatusCreated, rule)
+	})
+	s.handle("PUT firewalls/{firewallID}/forwarding-rules/{ruleID}", func(w http.ResponseWriter, r *http.Request) {
+		firewall, ok := s.firewalls[r.PathValue("firewallID")]
+		if !ok {
+			writeFakeXelonError(w, http.StatusNotFound, nil)
+			return
+		}
+		index := slices.IndexFunc(firewall.ForwardingRules, func(rule xelon.FirewallForwardingRule) bool {
+			return rule.ID == r.PathValue("ruleID")
+		})
+		if index < 0 {
+			writeFakeXelonError(w, http.StatusNotFound, nil)
+			return
+		}
+		var updateRequest xelon.FirewallUpdateForwardingRuleRequest
+		if !decodeFakeXelonRequest(w, r, &updateRequest) {
+			return
+		}
+		rule := &firewall.ForwardingRules[index]
+		rule.DestinationIPAddresses = updateRequest.DestinationIPAddresses
+		rule.ExternalPort = updateRequest.ExternalPort
+		rule.InternalPort = updateRequest.InternalPort
+		rule.Protocol = updateRequest.Protocol
+		rule.SourceIPAddresses = updateRequest.SourceIPAddresses
+		writeFakeXelonJSON(w, http.StatusOK, rule)
+	})
+	s.handle("DELETE firewalls/{firewallID}/forwarding-rules/{ruleID}", func(w http.ResponseWriter, r *http.Request) {
+		firewall, ok := s.firewalls[r.PathValue("firewallID")]
+		if !ok {
+			writeFakeXelonError(w, http.StatusNotFound, nil)
+			return
+		}
+		index := slices.IndexFunc(firewall.ForwardingRules, func(rule xelon.FirewallForwardingRule) bool {
+			return rule.ID == r.PathValue("ruleID")
+		})
+		if index < 0 {
+			writeFakeXelonError(w, http.StatusNotFound, nil)
+			return
+		}
+		firewall.ForwardingRules = slices.Delete(firewall.ForwardingRules, index, index+1)
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+func (s *fakeXelonServer) registerKubernetesRoutes() {
+	s.handle("GET kubernetes-talos/clouds/{cloudID}/versions", func(w http.ResponseWriter, _ *http.Request) {
+		writeFakeXelonJSON(w, http.StatusOK, s.kubernetesVersions)
+	})
+	s.handle("POST kubernetes-talos/clusters", func(w http.ResponseWriter, r *http.Request) {
+		var createRequest xelon.KubernetesClusterCreateRequest
+		if !decodeFakeXelonRequest(w, r, &createRequest) {
+			return
+		}
+		cluster := &xelon.KubernetesCluster{
+			Cloud:             &xelon.Cloud{ID: createRequest.CloudID},
+			Health:            &xelon.KubernetesClusterHealth{Status: "healthy"},
+			ID:                s.nextID(),
+			KubernetesVersion: createRequest.KubernetesVersion,
+			Name:              createRequest.Name,
+			Status:            "ready",
+			TalosVersion:      createRequest.TalosVersion,
+			Tenant:            &xelon.Tenant{ID: createRequest.TenantID},
+		}
+		controlPlane := &xelon.KubernetesClusterControlPlane{
+			CPUCores: createRequest.ControlPlaneCPUCores,
+			DiskSize: createRequest.ControlPlaneDiskSize,
+			RAM:      createRequest.ControlPlaneRAM,
+		}
+		controlPlaneNodeCount := 1
+		if createRequest.ControlPlaneType == "production" {
+			controlPlaneNodeCount = 3
+		}
+		for range controlPlaneNodeCount {
+			controlPlane.Nodes = append(controlPlane.Nodes, xelon.KubernetesClusterNode{ID: s.nextID(), Status: "ready"})
+		}
+		loadBalancer := &xelon.KubernetesClusterLoadBalancer{
+			CPUCores: createRequest.LoadBalancerCPUCores,
+			DiskSize: createRequest.LoadBalancerDiskSize,
+			RAM:      createRequest.LoadBalancerRAM,
+		}
+		loadBalancerInstanceCount := 1
+		if createRequest.LoadBalancerType == "production" {
+			loadBalancerInstanceCount = 2
+		}
+		for range loadBalancerInstanceCount {
+			loadBalancer.Instances = append(loadBalancer.Instances, xelon.KubernetesClusterNode{ID: s.nextID(), Status: "ready"})
+		}
+		s.kubernetesClusters[cluster.ID] = cluster
+		s.kubernetesControlPlanes[cluster.ID] = controlPlane
+		s.kubernetesLoadBalancers[cluster.ID] = loadBalancer
+		writeFakeXelonJSON(w, http.StatusCreated, cluster)
+	})
+	s.handle("GET kubernetes-talos/clusters/{clusterID}", func(w http.ResponseWriter, r *http.Request) {
+		writeFakeXelonObject(w, s.kubernetesClusters, r.PathValue("clusterID"))
+	})
+	s.handle("DELETE kubernetes-talos/clusters/{clusterID}", func(w http.ResponseWriter, r *http.Request) {
+		deleteFakeXelonObject(w, s.kubernetesClusters, r.PathValue("clusterID"))
+		delete(s.kubernetesControlPlanes, r.PathValue("clusterID"))
+		delete(s.kubernetesLoadBalancers, r.PathValue("clusterID"))
+		delete(s.kubernetesNodePools, r.PathValue("clusterID"))
+	})
+	s.handle("GET kubernetes-talos/clusters/{clusterID}/kubeconfig", func(w http.ResponseWriter, r *http.Request) {
+		clusterID := r.PathValue("clusterID")
+		if _, ok := s.kubernetesClusters[clusterID]; !ok {
+			writeFakeXelonError(w, http.StatusNotFound, nil)
+			return
+		}
+		writeFakeXelonJSON(w, http.StatusOK, map[string]string{"kubeconfig": fakeXelonKubeconfig(clusterID)})
+	})
+	s.handle("GET kubernetes-talos/clusters/{clusterID}/talosconfig", func(w http.ResponseWriter, r *http.Request) {
+		clusterID := r.PathValue("clusterID")
+		if _, ok := s.kubernetesClusters[clusterID]; !ok {
+			writeFakeXelonError(w, http.StatusNotFound, nil)
+			return
+		}
+		writeFakeXelonJSON(w, http.StatusOK, map[string]string{"talosconfig": fakeXelonTalosconfig(clusterID)})
+	})
+	s.handle("GET kubernetes-talos/clusters/{clusterID}/control-planes", func(w http.ResponseWriter, r *http.Request) {
+		writeFakeXelonObject(w, s.kubernetesControlPlanes, r.PathValue("clusterID"))
+	})
+	s.handle("GET kubernetes-talos/clusters/{clusterID}/load-balancers", func(w http.ResponseWriter, r *http.Request) {
+		writeFakeXelonObject(w, s.kubernetesLoadBalancers, r.PathValue("clusterID"))
+	})
+	s.handle("POST kubernetes-talos/clusters/{clusterID}/upgrade", func(w http.ResponseWriter, r *http.Request) {
+		cluster, ok := s.kubernetesClusters[r.PathValue("clusterID")]
+		if !ok {
+			writeFakeXelonError(w, http.StatusNotFound, nil)
+			return
+		}
+		var upgradeRequest xelon.KubernetesClusterUpgradeRequest
+		if !decodeFakeXelonRequest(w, r, &upgradeRequest) {
+			return
+		}
+		cluster.KubernetesVersion = upgradeRequest.KubernetesVersion
+		cluster.TalosVersion = upgradeRequest.TalosVersion
+		w.WriteHeader(http.StatusAccepted)
+	})
+	s.handle("GET kubernetes-talos/clusters/{clusterID}/node-pools/{nodePoolID}", func(w http.ResponseWriter, r *http.Request) {
+		for _, nodePool := range s.kubernetesNodePools[r.PathValue("clusterID")] {
+			if nodePool.ID == r.PathValue("nodePoolID") {
+				writeFakeXelonJSON(w, http.StatusOK, nodePool)
+				return
+			}
+		}
+		writeFakeXelonError(w, http.StatusNotFound, nil)
+	})
+	s.handle("POST kubernetes-talos/clusters/{clusterID}/node-pools", func(w http.ResponseWriter, r *http.Request) {
+		clusterID := r.PathValue("clusterID")
+		if _, ok := s.kubernetesClusters[clusterID]; !ok {
+			writeFakeXelonError(w, http.StatusNotFound, nil)
+			return
+		}
+		var createRequest xelon.KubernetesClusterNodePoolCreateRequest
+		if !decodeFakeXelonRequest(w, r, &createRequest) {
+			return
+		}
+		nodePool := xelon.KubernetesClusterNodePool{
+			CPUCores: createRequest.CPUCores,
+			DiskSize: createRequest.DiskSize,
+			ID:       s.nextID(),
+			Name:     createRequest.Name,
+			RAM:      createRequest.RAM,
+		}
+		for range createRequest.NodeCount {
+			nodeID := s.nextID()
+			nodePool.Nodes = append(nodePool.Nodes, xelon.KubernetesClusterNode{
+				ID:     nodeID,
+				Name:   createRequest.Name + "-" + nodeID,
+				Status: "ready",
+			})
+		}
+		s.kubernetesNodePools[clusterID] = append(s.kubernetesNodePools[clusterID], nodePool)
+		writeFakeXelonJSON(w, http.StatusCreated, nodePool)
+	})
+	s.handle("DELETE kubernetes-talos/clusters/{clusterID}/node-pools/{nodePoolID}", func(w http.ResponseWriter, r *http.Request) {
+		clusterID := r.PathValue("clusterID")
+		nodePools := s.kubernetesNodePools[clusterID]
+		index := slices.IndexFunc(nodePools, func(nodePool xelon.KubernetesClusterNodePool) bool {
+			return nodePool.ID == r.PathValue("nodePoolID")
+		})
+		if index < 0 {
+			writeFakeXelonError(w, http.StatusNotFound, nil)
+			return
+		}
+		s.kubernetesNodePools[clusterID] = slices.Delete(nodePools, index, index+1)
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+func (s *fakeXelonServer) registerLoadBalancerRoutes() {
+	s.handle("GET load-balancers", func(w http.ResponseWriter, _ *http.Request) {
+		writeFakeXelonList(w, fakeXelonValues(s.loadBalancers))
+	})
+	s.handle("POST load-balancers", func(w http.ResponseWriter, r *http.Request) {
+		var createRequest xelon.LoadBalancerCreateRequest
+		if !decodeFakeXelonRequest(w, r, &createRequest) {
+			return
+		}
+		loadBalancer := &xelon.LoadBalancer{
+			Cloud:             &xelon.Cloud{ID: createRequest.CloudID},
+			ExternalIPAddress: "198.51.100.20",
+			HealthStatus:      "healthy",
+			ID:                s.nextID(),
+			InternalIPAddress: createRequest.InternalIPAddress,
+			Name:              createRequest.Name,
+			State:             1,
+			Tenant:            &xelon.Tenant{ID: createRequest.TenantID},
+			Type:              createRequest.Type,
+		}
+		for _, deviceID := range createRequest.AssignedDeviceIDs {
+			loadBalancer.AssignedDevices = append(loadBalancer.AssignedDevices, xelon.Device{ID: deviceID})
+		}
+		s.loadBalancers[loadBalancer.ID] = loadBalancer
+		writeFakeXelonJSON(w, http.StatusCreated, loadBalancer)
+	})
+	s.handle("GET load-balancers/{loadBalancerID}", func(w http.ResponseWriter, r *http.Request) {
+		writeFakeXelonObject(w, s.loadBalancers, r.PathValue("loadBalancerID"))
+	})
+	s.handle("PATCH load-balancers/{loadBalancerID}", func(w http.ResponseWriter, r *http.Request) {
+		loadBalancer, ok := s.loadBalancers[r.PathValue("loadBalancerID")]
+		if !ok {
+			writeFakeXelonError(w, http.StatusNotFound, nil)
+			return
+		}
+		var updateRequest xelon.LoadBalancerUpdateRequest
+		if !decodeFakeXelonRequest(w, r, &updateRequest) {
+			return
+		}
+		loadBalancer.Name = updateRequest.Name
+		writeFakeXelonJSON(w, http.StatusOK, loadBalancer)
+	})
+	s.handle("PUT load-balancers/{loadBalancerID}/assigned-devices", func(w http.ResponseWriter, r *http.Request) {
+		loadBalancer, ok := s.loadBalancers[r.PathValue("loadBalancerID")]
+		if !ok {
+			writeFakeXelonError(w, http.StatusNotFound, nil)
+			return
+		}
+		var updateRequest xelon.LoadBalancerUpdateAssignedDevicesRequest
+		if !decodeFakeXelonRequest(w, r, &updateRequest) {
+			return
+		}
+		loadBalancer.AssignedDevices = nil
+		for _, deviceID := range updateRequest.DeviceIDs {
+			loadBalancer.AssignedDevices = append(loadBalancer.AssignedDevices, xelon.Device{ID: deviceID})
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+	s.handle("DELETE load-balancers/{loadBalancerID}", func(w http.ResponseWriter, r *http.Request) {
+		deleteFakeXelonObject(w, s.loadBalancers, r.PathValue("loadBalancerID"))
+	})
+	s.handle("POST load-balancers/{loadBalancerID}/forwarding-rules", func(w http.ResponseWriter, r *http.Request) {
+		loadBalancer, ok := s.loadBalancers[r.PathValue("loadBalancerID")]
+		if !ok {
+			writeFakeXelonError(w, http.StatusNotFound, nil)
+			return
+		}
+		var createRequest xelon.LoadBalancerCreateForwardingRuleRequest
+		if !decodeFakeXelonRequest(w, r, &createRequest) {
+			return
+		}
+		rule := createRequest.LoadBalancerForwardingRule
+		rule.ID = s.nextID()
+		loadBalancer.ForwardingRules = append(loadBalancer.ForwardingRules, rule)
+		writeFakeXelonJSON(w, http.StatusCreated, rule)
+	})
+	s.handle("PUT load-balancers/{loadBalancerID}/forwarding-rules/{ruleID}", func(w http.ResponseWriter, r *http.Request) {
+		loadBalancer, ok := s.loadBalancers[r.PathValue("loadBalancerID")]
+		if !ok {
+			writeFakeXelonError(w, http.StatusNotFound, nil)
+			return
+		}
+		index := slices.IndexFunc(loadBalancer.ForwardingRules, func(rule xelon.LoadBalancerForwardingRule) bool {
+			return rule.ID == r.PathValue("ruleID")
+		})
+		if index < 0 {
+			writeFakeXelonError(w, http.StatusNotFound, nil)
+			return
+		}
+		var updateRequest xelon.LoadBalancerUpdateForwardingRuleRequest
+		if !decodeFakeXelonRequest(w, r, &updateRequest) {
+			return
+		}
+		rule := updateRequest.LoadBalancerForwardingRule
+		rule.ID = r.PathValue("ruleID")
+		loadBalancer.ForwardingRules[index] = rule
+		writeFakeXelonJSON(w, http.StatusOK, rule)
+	})
+	s.handle("DELETE load-balancers/{loadBalancerID}/forwarding-rules/{ruleID}", func(w http.ResponseWriter, r *http.Request) {
+		loadBalancer, ok := s.loadBalancers[r.PathValue("loadBalancerID")]
+		if !ok {
+			writeFakeXelonError(w, http.StatusNotFound, nil)
+			return
+		}
+		index := slices.IndexFunc(loadBalancer.ForwardingRules, func(rule xelon.LoadBalancerForwardingRule) bool {
+			return rule.ID == r.PathValue("ruleID")
+		})
+		if index < 0 {
+			writeFakeXelonError(w, http.StatusNotFound, nil)
+			return
+		}
+		loadBalancer.ForwardingRules = slices.Delete(loadBalancer.ForwardingRules, index, index+1)
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+func (s *fakeXelonServer) registerNetworkRoutes() {
+	s.handle("GET networks", func(w http.ResponseWriter, _ *http.Request) {
+		writeFakeXelonList(w, fakeXelonValues(s.networks))
+	})
+	s.handle("POST networks/lan", func(w http.ResponseWriter, r *http.Request) {
+		var createRequest xelon.NetworkLANCreateRequest
+		if !decodeFakeXelonRequest(w, r, &createRequest) {
+			return
+		}
+		network := &xelon.Network{
+			Clouds:       []xelon.Cloud{{ID: createRequest.CloudID}},
+			DNSPrimary:   createRequest.DNSPrimary,
+			DNSSecondary: createRequest.DNSSecondary,
+			Gateway:      createRequest.Gateway,
+			ID:           s.nextID(),
+			Name:         createRequest.Name,
+			Network:      createRequest.Network,
+			NetworkSpeed: createRequest.NetworkSpeed,
+			Owner:        &xelon.Tenant{ID: createRequest.TenantID},
+			SubnetSize:   createRequest.SubnetSize,
+			Type:         "LAN",
+		}
+		s.networks[network.ID] = network
+		writeFakeXelonJSON(w, http.StatusCreated, network)
+	})
+	s.handle("POST networks/wan", func(w http.ResponseWriter, r *http.Request) {
+		var createRequest xelon.NetworkWANCreateRequest
+		if !decodeFakeXelonRequest(w, r, &createRequest) {
+			return
+		}
+		network := &xelon.Network{
+			Clouds:       []xelon.Cloud{{ID: createRequest.CloudID}},
+			ID:           s.nextID(),
+			Name:         createRequest.Name,
+			Network:      "198.51.100.0",
+			NetworkSpeed: createRequest.NetworkSpeed,
+			Owner:        &xelon.Tenant{ID: createRequest.TenantID},
+			SubnetSize:   createRequest.SubnetSize,
+			Type:         "WAN",
+		}
+		s.networks[network.ID] = network
+		writeFakeXelonJSON(w, http.StatusCreated, network)
+	})
+	s.handle("GET networks/{networkID}", func(w http.ResponseWriter, r *http.Request) {
+		writeFakeXelonObject(w, s.networks, r.PathValue("networkID"))
+	})
+	s.handle("PATCH networks/{networkID}/lan", func(w http.ResponseWriter, r *http.Request) {
+		network, ok := s.networks[r.PathValue("networkID")]
+		if !ok {
+			writeFakeXelonError(w, http.StatusNotFound, nil)
+			return
+		}
+		var updateRequest xelon.NetworkLANUpdateRequest
+		if !decodeFakeXelonRequest(w, r, &updateRequest) {
+			return
+		}
+		network.DNSPrimary = updateRequest.DNSPrimary
+		network.DNSSecondary = updateRequest.DNSSecondary
+		network.Gateway = updateRequest.Gateway
+		network.Name = updateRequest.Name
+		network.Network = updateRequest.Network
+		network.NetworkSpeed = updateRequest.NetworkSpeed
+		writeFakeXelonJSON(w, http.StatusOK, network)
+	})
+	s.handle("PATCH networks/{networkID}/wan", func(w http.ResponseWriter, r *http.Request) {
+		network, ok := s.networks[r.PathValue("networkID")]
+		if !ok {
+			writeFakeXelonError(w, http.StatusNotFound, nil)
+			return
+		}
+		var updateRequest xelon.NetworkWANUpdateRequest
+		if !decodeFakeXelonRequest(w, r, &updateRequest) {
+			return
+		}
+		network.DNSPrimary = updateRequest.DNSPrimary
+		network.DNSSecondary = updateRequest.DNSSecondary
+		network.Gateway = updateRequest.Gateway
+		network.Name = updateRequest.Name
+		network.NetworkSpeed = updateRequest.NetworkSpeed
+		writeFakeXelonJSON(w, http.StatusOK, network)
+	})
+	s.handle("DELETE networks/{networkID}", func(w http.ResponseWriter, r *http.Request) {
+		deleteFakeXelonObject(w, s.networks, r.PathValue("networkID"))
+	})
+}
+
+func (s *fakeXelonServer) registerObjectStorageRoutes() {
+	s.handle("GET object-storages/users", func(w http.ResponseWriter, _ *http.Request) {
+		writeFakeXelonList(w, fakeXelonValues(s.objectStorageUsers))
+	})
+	s.handle("POST object-storages/users", func(w http.ResponseWriter, r *http.Request) {
+		var createRequest xelon.ObjectStorageUserCreateRequest
+		if !decodeFakeXelonRequest(w, r, &createRequest) {
+			return
+		}
+		user := &xelon.ObjectStorageUser{
+			ID:          s.nextID(),
+			Name:        createRequest.Name,
+			QuotaGB:     createRequest.QuotaGB,
+			S3Endpoints: []string{"https://s3.fake.xelon.ch"},
+			Tenant:      &xelon.Tenant{ID: createRequest.TenantID},
+		}
+		s.objectStorageUsers[user.ID] = user
+		writeFakeXelonJSON(w, http.StatusCreated, user)
+	})
+	s.handle("GET object-storages/users/{userID}", func(w http.ResponseWriter, r *http.Request) {
+		writeFakeXelonObject(w, s.objectStorageUsers, r.PathValue("userID"))
+	})
+	s.handle("PATCH object-storages/users/{userID}", func(w http.ResponseWriter, r *http.Request) {
+		user, ok := s.objectStorageUsers[r.PathValue("userID")]
+		if !ok {
+			writeFakeXelonError(w, http.StatusNotFound, nil)
+			return
+		}
+		var updateRequest xelon.ObjectStorageUserUpdateRequest
+		if !decodeFakeXelonRequest(w, r, &updateRequest) {
+			return
+		}
+		user.Name = updateRequest.Name
+		user.QuotaGB = updateRequest.QuotaGB
+		writeFakeXelonJSON(w, http.StatusOK, user)
+	})
+	s.handle("DELETE object-storages/users/{userID}", func(w http.ResponseWriter, r *http.Request) {
+		deleteFakeXelonObject(w, s.objectStorageUsers, r.PathValue("userID"))
+	})
+	s.handle("POST object-storages/users/{userID}/tokens", func(w http.ResponseWriter, r *http.Request) {
+		user, ok := s.objectStorageUsers[r.PathValue("userID")]
+		if !ok {
+			writeFakeXelonError(w, http.StatusNotFound, nil)
+			return
+		}
+		tokenID := s.nextID()
+		token := xelon.ObjectStorageUserToken{
+			AccessKey: "FAKEACCESSKEY" + tokenID,
+			ID:        tokenID,
+			SecretKey: "fake-secret-key-" + tokenID,
+		}
+		user.Tokens = append(user.Tokens, token)
+		writeFakeXelonJSON(w, http.StatusCreated, token)
+	})
+	s.handle("DELETE object-storages/users/{userID}/tokens/{tokenID}", func(w http.ResponseWriter, r *http.Request) {
+		user, ok := s.objectStorageUsers[r.PathValue("userID")]
+		if !ok {
+			writeFakeXelonError(w, http.StatusNotFound, nil)
+			return
+		}
+		index := slices.IndexFunc(user.Tokens, func(token xelon.ObjectStorageUserToken) bool {
+			return token.ID == r.PathValue("tokenID")
+		})
+		if index < 0 {
+			writeFakeXelonError(w, http.StatusNotFound, nil)
+			return
+		}
+		user.Tokens = slices.Delete(user.Tokens, index, index+1)
+		w.WriteHeader(http.StatusNoContent)
+	})
+	s.handle("GET object-storages/buckets", func(w http.ResponseWriter, _ *http.Request) {
+		writeFakeXelonList(w, fakeXelonValues(s.objectStorageBuckets))
+	})
+	s.handle("POST object-storages/buckets", func(w http.ResponseWriter, r *http.Request) {
+		var createRequest xelon.ObjectStorageBucketCreateRequest
+		if !decodeFakeXelonRequest(w, r, &createRequest) {
+			return
+		}
+		if _, ok := s.objectStorageUsers[createRequest.ObjectStorageUserID]; !ok {
+			writeFakeXelonError(w, http.StatusUnprocessableEntity, map[string]any{
+				"object_storage_user_id": []any{"The selected object storage user id is invalid."},
+			})
+			return
+		}
+		bucket := &xelon.ObjectStorageBucket{
+			ID:                      s.nextID(),
+			Name:                    createRequest.Name,
+			ObjectLockEnabled:       createRequest.ObjectLockEnabled,
+			ObjectLockRetentionDays: createRequest.ObjectLockRetentionDays,
+			ObjectStorageUserID:     createRequest.ObjectStorageUserID,
+			S3Endpoints:             []string{"https://s3.fake.xelon.ch"},
+			VersioningEnabled:       createRequest.VersioningEnabled,
+		}
+		s.objectStorageBuckets[bucket.ID] = bucket
+		writeFakeXelonJSON(w, http.StatusCreated, bucket)
+	})
+	s.handle("GET object-storages/users/{userID}/buckets/{bucketID}", func(w http.ResponseWriter, r *http.Request) {
+		writeFakeXelonObject(w, s.objectStorageBuckets, r.PathValue("bucketID"))
+	})
+	s.handle("PATCH object-storages/users/{userID}/buckets/{bucketID}/versioning", func(w http.ResponseWriter, r *http.Request) {
+		bucket, ok := s.objectStorageBuckets[r.PathValue("bucketID")]
+		if !ok {
+			writeFakeXelonError(w, http.StatusNotFound, nil)
+			return
+		}
+		var updateRequest xelon.ObjectStorageBucketVersioningUpdateRequest
+		if !decodeFakeXelonRequest(w, r, &updateRequest) {
+			return
+		}
+		bucket.VersioningEnabled = updateRequest.VersioningEnabled
+		w.WriteHeader(http.StatusNoContent)
+	})
+	s.handle("DELETE object-storages/users/{userID}/buckets/{bucketID}", func(w http.ResponseWriter, r *http.Request) {
+		deleteFakeXelonObject(w, s.objectStorageBuckets, r.PathValue("bucketID"))
+	})
+}
+
+func (s *fakeXelonServer) registerSSHKeyRoutes() {
+	s.handle("GET ssh-keys", func(w http.ResponseWriter, _ *http.Request) {
+		writeFakeXelonList(w, fakeXelonValues(s.sshKeys))
+	})
+	s.handle("POST ssh-keys", func(w http.ResponseWriter, r *http.Request) {
+		var createRequest xelon.SSHKeyCreateRequest
+		if !decodeFakeXelonRequest(w, r, &createRequest) {
+			return
+		}
+		sshKey := &xelon.SSHKey{
+			ID:        s.nextID(),
+			Name:      createRequest.Name,
+			PublicKey: createRequest.PublicKey,
+		}
+		s.sshKeys[sshKey.ID] = sshKey
+		writeFakeXelonJSON(w, http.StatusCreated, sshKey)
+	})
+	s.handle("GET ssh-keys/{sshKeyID}", func(w http.ResponseWriter, r *http.Request) {
+		writeFakeXelonObject(w, s.sshKeys, r.PathValue("sshKeyID"))
+	})
+	s.handle("PUT ssh-keys/{sshKeyID}", func(w http.ResponseWriter, r *http.Request) {
+		sshKey, ok := s.sshKeys[r.PathValue("sshKeyID")]
+		if !ok {
+			writeFakeXelonError(w, http.StatusNotFound, nil)
+			return
+		}
+		var updateRequest xelon.SSHKeyUpdateRequest
+		if !decodeFakeXelonRequest(w, r, &updateRequest) {
+			return
+		}
+		sshKey.Name = updateRequest.Name
+		writeFakeXelonJSON(w, http.StatusOK, sshKey)
+	})
+	s.handle("DELETE ssh-keys/{sshKeyID}", func(w http.ResponseWriter, r *http.Request) {
+		deleteFakeXelonObject(w, s.sshKeys, r.PathValue("sshKeyID"))
+	})
+}
+
+func (s *fakeXelonServer) registerTenantRoutes() {
+	s.handle("GET tenants/current", func(w http.ResponseWriter, _ *http.Request) {
+		writeFakeXelonJSON(w, http.StatusOK, s.tenant)
+	})
+	s.handle("GET tenants/{tenantID}/users", func(w http.ResponseWriter, _ *http.Request) {
+		users := make([]xelon.TenantUser, 0, len(s.tenantUsers))
+		for _, user := range fakeXelonValues(s.tenantUsers) {
+			users = append(users, user.TenantUser)
+		}
+		writeFakeXelonList(w, users)
+	})
+	s.handle("POST tenants/{tenantID}/users", func(w http.ResponseWriter, r *http.Request) {
+		var createRequest xelon.TenantUserCreateRequest
+		if !decodeFakeXelonRequest(w, r, &createRequest) {
+			return
+		}
+		for _, user := range s.tenantUsers {
+			if user.Email == createRequest.Email {
+				writeFakeXelonError(w, http.StatusUnprocessableEntity, map[string]any{
+					"email": []any{"The email has already been taken."},
+				})
+				return
+			}
+		}
+		user := &xelon.TenantUserWithDetails{
+			TenantUser: xelon.TenantUser{
+				Email:     createRequest.Email,
+				FirstName: createRequest.FirstName,
+				ID:        s.nextID(),
+				IsActive:  true,
+				LastName:  createRequest.LastName,
+			},
+			IsActive: true,
+		}
+		setFakeTenantUserPermissions(user, createRequest.Roles, createRequest.Permissions)
+		s.tenantUsers[user.ID] = user
+		writeFakeXelonJSON(w, http.StatusCreated, user.TenantUser)
+	})
+	s.handle("GET tenants/{tenantID}/users/{userID}", func(w http.ResponseWriter, r *http.Request) {
+		writeFakeXelonObject(w, s.tenantUsers, r.PathValue("userID"))
+	})
+	s.handle("PATCH tenants/{tenantID}/users/{userID}", func(w http.ResponseWriter, r *http.Request) {
+		user, ok := s.tenantUsers[r.PathValue("userID")]
+		if !ok {
+			writeFakeXelonError(w, http.StatusNotFound, nil)
+			return
+		}
+		var updateRequest xelon.TenantUserUpdateRequest
+		if !decodeFakeXelonRequest(w, r, &updateRequest) {
+			return
+		}
+		user.FirstName = updateRequest.FirstName
+		user.LastName = updateRequest.LastName
+		writeFakeXelonJSON(w, http.StatusOK, user.TenantUser)
+	})
+	s.handle("PATCH tenants/{tenantID}/users/{userID}/password", func(w http.ResponseWriter, r *http.Request) {
+		if _, ok := s.tenantUsers[r.PathValue("userID")]; !ok {
+			writeFakeXelonError(w, http.StatusNotFound, nil)
+			return
+		}
+		var updateRequest xelon.TenantUserPasswordUpdateRequest
+		if !decodeFakeXelonRequest(w, r, &updateRequest) {
+			return
+		}
+		if updateRequest.Password != updateRequest.PasswordConfirmation {
+			writeFakeXelonError(w, http.StatusUnprocessableEntity, map[string]any{
+				"password": []any{"The password confirmation does not match."},
+			})
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+	s.handle("PATCH tenants/{tenantID}/users/{userID}/permissions", func(w http.ResponseWriter, r *http.Request) {
+		user, ok := s.tenantUsers[r.PathValue("userID")]
+		if !ok {
+			writeFakeXelonError(w, http.StatusNotFound, nil)
+			return
+		}
+		var updateRequest xelon.TenantUserPermissionsUpdateRequest
+		if !decodeFakeXelonRequest(w, r, &updateRequest) {
+			return
+		}
+		setFakeTenantUserPermissions(user, updateRequest.Roles, updateRequest.Permissions)
+		w.WriteHeader(http.StatusNoContent)
+	})
+	s.handle("DELETE tenants/{tenantID}/users/{userID}", func(w http.ResponseWriter, r *http.Request) {
+		deleteFakeXelonObject(w, s.tenantUsers, r.PathValue("userID"))
+	})
+}
+
+// fakeXelonKubeconfig returns the kubeconfig of the cluster, with the API
+// server https://<clusterID>.xks.example.com:6443 and the CA certificate
+// "CA CERTIFICATE <clusterID>".
+func fakeXelonKubeconfig(clusterID string) string {
+	return fmt.Sprintf(`apiVersion: v1
+kind: Config
+clusters:
+  - name: %[1]s
+    cluster:
+      server: https://%[1]s.xks.example.com:6443
+      certificate-authority-data: %[2]s
+contexts:
+  - name: admin@%[1]s
+    context:
+      cluster: %[1]s
+      user: admin@%[1]s
+current-context: admin@%[1]s
+users:
+  - name: admin@%[1]s
+    user:
+      client-certificate-data: %[3]s
+      client-key-data: %[4]s
+`, clusterID, encodeFakeXelonPEM("CA CERTIFICATE "+clusterID),
+		encodeFakeXelonPEM("CLIENT CERTIFICATE "+clusterID), encodeFakeXelonPEM("CLIENT KEY "+clusterID))
+}
+
+// fakeXelonTalosconfig returns the talosconfig of the cluster, with the
+// endpoint 10.0.0.10 and the CA certificate "CA CERTIFICATE <clusterID>".
+func fakeXelonTalosconfig(clusterID string) string {
+	return fmt.Sprintf(`context: %[1]s
+contexts:
+  %[1]s:
+    endpoints:
+      - 10.0.0.10
+    nodes:
+      - 10.0.0.11
+    ca: %[2]s
+    crt: %[3]s
+    key: %[4]s
+`, clusterID, encodeFakeXelonPEM("CA CERTIFICATE "+clusterID),
+		encodeFakeXelonPEM("CLIENT CERTIFICATE "+clusterID), encodeFakeXelonPEM("CLIENT KEY "+clusterID))
+}
+
+func encodeFakeXelonPEM(content string) string {
+	return base64.StdEncoding.EncodeToString([]byte(content))
+}
+
+func setFakeTenantUserPermissions(user *xelon.TenantUserWithDetails, roles, permissions []string) {
+	user.Roles = nil
+	for _, role := range roles {
+		user.Roles = append(user.Roles, xelon.TenantUserRole{Name: role})
+	}
+	user.Permissions = nil
+	for _, permission := range permissions {
+		user.Permissions = append(user.Permissions, xelon.TenantUserPermission{Name: permission})
+	}
+}
+
+// fakeXelonValues returns map values sorted by numeric ID to keep list
+// responses stable.
+func fakeXelonValues[T any](objects map[string]*T) []T {
+	ids := make([]string, 0, len(objects))
+	for id := range objects {
+		ids = append(ids, id)
+	}
+	slices.SortFunc(ids, func(a, b string) int {
+		x, _ := strconv.Atoi(a)
+		y, _ := strconv.Atoi(b)
+		return x - y
+	})
+
+	values := make([]T, 0, len(ids))
+	for _, id := range ids {
+		values = append(values, *objects[id])
+	}
+	return values
+}
+
+func decodeFakeXelonRequest(w http.ResponseWriter, r *http.Request, v any) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeFakeXelonError(w, http.StatusBadRequest, map[string]any{"body": []any{err.Error()}})
+		return false
+	}
+	return true
+}
+
+func writeFakeXelonObject[T any](w http.ResponseWriter, objects map[string]*T, id string) {
+	object, ok := objects[id]
+	if !ok {
+		writeFakeXelonError(w, http.StatusNotFound, nil)
+		return
+	}
+	writeFakeXelonJSON(w, http.StatusOK, object)
+}
+
+func deleteFakeXelonObject[T any](w http.ResponseWriter, objects map[string]*T, id string) {
+	if _, ok := objects[id]; !ok {
+		writeFakeXelonError(w, http.StatusNotFound, nil)
+		return
+	}
+	delete(objects, id)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func writeFakeXelonList[T any](w http.ResponseWriter, items []T) {
+	if items == nil {
+		items = []T{}
+	}
+	writeFakeXelonJSON(w, http.StatusOK, map[string]any{
+		"data": items,
+		"meta": xelon.Meta{Page: 1, LastPage: 1, PerPage: len(items), Total: len(items)},
+	})
+}
+
+func writeFakeXelonError(w http.ResponseWriter, statusCode int, validations map[string]any) {
+	writeFakeXelonJSON(w, statusCode, xelon.ErrorElement{
+		Message:     http.StatusText(statusCode),
+		Validations: validations,
+	})
+}
+
+func writeFakeXelonJSON(w http.ResponseWriter, statusCode int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	_ = json.NewEncoder(w).Encode(v)
+}